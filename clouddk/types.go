@@ -35,6 +35,42 @@ func (r CustomBool) MarshalJSON() ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
+// CustomFloat allows a JSON floating point value to also be a string
+type CustomFloat float64
+
+// UnmarshalJSON converts a JSON value to a floating point number.
+func (r *CustomFloat) UnmarshalJSON(b []byte) error {
+	s := string(b)
+
+	if s == "null" {
+		*r = CustomFloat(0)
+
+		return nil
+	}
+
+	if strings.Contains(s, "\"") {
+		var v interface{}
+
+		err := json.Unmarshal(b, &v)
+
+		if err != nil {
+			return err
+		}
+
+		s = v.(string)
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+
+	if err != nil {
+		return err
+	}
+
+	*r = CustomFloat(f)
+
+	return nil
+}
+
 // CustomInt allows a JSON integer value to also be a string
 type CustomInt int
 
@@ -42,6 +78,12 @@ type CustomInt int
 func (r *CustomInt) UnmarshalJSON(b []byte) error {
 	s := string(b)
 
+	if s == "null" {
+		*r = CustomInt(0)
+
+		return nil
+	}
+
 	if strings.Contains(s, "\"") {
 		var v interface{}
 
@@ -65,6 +107,40 @@ func (r *CustomInt) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// AccountBody describes an account object.
+type AccountBody struct {
+	Balance  CustomFloat       `json:"balance"`
+	Currency string            `json:"currency"`
+	Limits   AccountLimitsBody `json:"limits"`
+}
+
+// AccountLimitsBody describes the resource limits for an account. A limit of zero means that the resource is unlimited.
+type AccountLimitsBody struct {
+	CloudServers CustomInt `json:"cloudservers"`
+	Disks        CustomInt `json:"disks"`
+	IPAddresses  CustomInt `json:"ip_addresses"`
+}
+
+// BackupBody describes a server backup object.
+type BackupBody struct {
+	Identifier string    `json:"identifier"`
+	Label      string    `json:"label"`
+	Type       string    `json:"type"`
+	Size       CustomInt `json:"size"`
+	CreatedAt  string    `json:"created_at"`
+}
+
+// BackupListBody describes a server backup list.
+type BackupListBody []BackupBody
+
+// BackupPolicyBody describes a server backup policy object.
+type BackupPolicyBody struct {
+	Enabled   CustomBool `json:"enabled"`
+	Schedule  string     `json:"schedule"`
+	Hour      CustomInt  `json:"hour"`
+	Retention CustomInt  `json:"retention"`
+}
+
 // ClientSettings describes the client settings.
 type ClientSettings struct {
 	Endpoint string
@@ -124,6 +200,25 @@ type IPAddressBody struct {
 	Netmask                    string `json:"netmask"`
 	Gateway                    string `json:"gateway"`
 	NetworkInterfaceIdentifier string `json:"network_interface_identifier"`
+	ReverseDNS                 string `json:"reverse_dns"`
+}
+
+// IPAddressCreateBody describes an IP address creation object.
+type IPAddressCreateBody struct {
+	NetworkInterfaceIdentifier string `json:"network_interface_identifier,omitempty"`
+}
+
+// IPAddressMoveBody describes an IP address reassignment object.
+type IPAddressMoveBody struct {
+	Address                          string `json:"address"`
+	TargetServerIdentifier           string `json:"target_cloudserver_identifier"`
+	TargetNetworkInterfaceIdentifier string `json:"target_network_interface_identifier,omitempty"`
+}
+
+// IPAddressReverseDNSBody describes an IP address reverse DNS update object.
+type IPAddressReverseDNSBody struct {
+	Address    string `json:"address"`
+	ReverseDNS string `json:"reverse_dns"`
 }
 
 // IPAddressListBody describes an IP address list.
@@ -131,8 +226,12 @@ type IPAddressListBody []IPAddressBody
 
 // LocationBody describes a datacenter location object.
 type LocationBody struct {
-	Identifier string `json:"identifier"`
-	Name       string `json:"name"`
+	Identifier string           `json:"identifier"`
+	Name       string           `json:"name"`
+	Country    string           `json:"country"`
+	City       string           `json:"city"`
+	Packages   PackageeListBody `json:"packages"`
+	Templates  TemplateListBody `json:"templates"`
 }
 
 // LocationListBody describes a datacenter location list.
@@ -158,28 +257,83 @@ type NetworkInterfaceBody struct {
 	RateLimit           CustomInt            `json:"rate_limit"`
 	DefaultFirewallRule string               `json:"default_firewall_rule"`
 	Primary             CustomBool           `json:"primary"`
+	PrivateNetwork      string               `json:"private_network_identifier"`
 	IPAddresses         IPAddressListBody    `json:"ipAddresses"`
 	FirewallRules       FirewallRuleListBody `json:"firewallRules"`
 }
 
+// NetworkInterfaceCreateBody describes a network interface creation object.
+type NetworkInterfaceCreateBody struct {
+	Label          string `json:"label"`
+	PrivateNetwork string `json:"private_network_identifier"`
+	IPAddress      string `json:"ip_address,omitempty"`
+}
+
 // NetworkInterfaceListBody describes a network interface list.
 type NetworkInterfaceListBody []NetworkInterfaceBody
 
 // NetworkInterfaceUpdateBody describes a network interface update object.
 type NetworkInterfaceUpdateBody struct {
-	Label               string `json:"label"`
-	DefaultFirewallRule string `json:"default_firewall_rule"`
+	Label               string    `json:"label"`
+	DefaultFirewallRule string    `json:"default_firewall_rule"`
+	RateLimit           CustomInt `json:"rate_limit"`
 }
 
 // PackageBody describes a server package object.
 type PackageBody struct {
-	Identifier string `json:"identifier"`
-	Name       string `json:"name"`
+	Identifier   string      `json:"identifier"`
+	Name         string      `json:"name"`
+	CPUs         CustomInt   `json:"cpus"`
+	Memory       CustomInt   `json:"memory"`
+	DiskSize     CustomInt   `json:"disk"`
+	Bandwidth    CustomInt   `json:"bandwidth"`
+	MonthlyPrice CustomFloat `json:"monthly_price"`
 }
 
 // PackageeListBody describes a server package list.
 type PackageeListBody []PackageBody
 
+// PrivateNetworkBody describes a private network object.
+type PrivateNetworkBody struct {
+	Identifier string       `json:"identifier"`
+	Label      string       `json:"label"`
+	CIDR       string       `json:"cidr"`
+	Location   LocationBody `json:"location"`
+}
+
+// PrivateNetworkCreateBody describes a private network creation object.
+type PrivateNetworkCreateBody struct {
+	Label    string `json:"label"`
+	CIDR     string `json:"cidr"`
+	Location string `json:"location"`
+}
+
+// PrivateNetworkUpdateBody describes a private network update object.
+type PrivateNetworkUpdateBody struct {
+	Label string `json:"label"`
+}
+
+// SSHKeyBody describes an SSH key object.
+type SSHKeyBody struct {
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+	PublicKey  string `json:"public_key"`
+}
+
+// SSHKeyCreateBody describes an SSH key creation object.
+type SSHKeyCreateBody struct {
+	Name      string `json:"name"`
+	PublicKey string `json:"public_key"`
+}
+
+// SSHKeyListBody describes an SSH key list.
+type SSHKeyListBody []SSHKeyBody
+
+// SSHKeyUpdateBody describes an SSH key update object.
+type SSHKeyUpdateBody struct {
+	Name string `json:"name"`
+}
+
 // ServerBody describes a server object.
 type ServerBody struct {
 	Identifier        string                   `json:"identifier"`
@@ -197,12 +351,15 @@ type ServerBody struct {
 
 // ServerCreateBody describes a server creation object.
 type ServerCreateBody struct {
-	Hostname            string `json:"hostname"`
-	Label               string `json:"label"`
-	InitialRootPassword string `json:"initialRootPassword"`
-	Package             string `json:"package"`
-	Template            string `json:"template"`
-	Location            string `json:"location"`
+	Hostname            string   `json:"hostname"`
+	Label               string   `json:"label"`
+	InitialRootPassword string   `json:"initialRootPassword"`
+	Package             string   `json:"package"`
+	Template            string   `json:"template,omitempty"`
+	Snapshot            string   `json:"snapshot,omitempty"`
+	Location            string   `json:"location"`
+	UserData            string   `json:"user_data,omitempty"`
+	SSHKeys             []string `json:"ssh_keys,omitempty"`
 }
 
 // ServerUpgradeBody describes a server upgrade object.
@@ -220,6 +377,21 @@ type ServerUpdateBody struct {
 	Label    string `json:"label"`
 }
 
+// SnapshotBody describes a server snapshot object.
+type SnapshotBody struct {
+	Identifier string `json:"identifier"`
+	Label      string `json:"label"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// SnapshotCreateBody describes a server snapshot creation object.
+type SnapshotCreateBody struct {
+	Label string `json:"label"`
+}
+
+// SnapshotListBody describes a server snapshot list.
+type SnapshotListBody []SnapshotBody
+
 // TemplateBody describes a datacenter location object.
 type TemplateBody struct {
 	Identifier string `json:"identifier"`
@@ -0,0 +1,187 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	accountQuotaDisks       = "disks"
+	accountQuotaIPAddresses = "IP addresses"
+	accountQuotaServers     = "servers"
+)
+
+var (
+	accountQuotas = newAccountQuotaChecker(false)
+)
+
+// accountQuota describes the limit and current usage for a type of resource.
+type accountQuota struct {
+	limit int
+	usage int
+}
+
+// accountQuotaChecker compares the resources created by the planned changes against the remaining account quotas.
+type accountQuotaChecker struct {
+	created map[string]int
+	enabled bool
+	mutex   sync.Mutex
+	planned map[string]map[string]int
+	quotas  map[string]accountQuota
+}
+
+// accountQuotaResource describes the methods shared by schema.ResourceData and schema.ResourceDiff, which are needed to compute a reservation.
+type accountQuotaResource interface {
+	GetChange(key string) (interface{}, interface{})
+	Id() string
+}
+
+// newAccountQuotaChecker returns a new quota checker, which does nothing unless enabled.
+func newAccountQuotaChecker(enabled bool) *accountQuotaChecker {
+	return &accountQuotaChecker{
+		created: make(map[string]int),
+		enabled: enabled,
+		planned: make(map[string]map[string]int),
+	}
+}
+
+// accountQuotaKey returns the key identifying the reservation for an existing resource or an empty string for a new resource.
+func accountQuotaKey(resourceType string, d accountQuotaResource) string {
+	if id := d.Id(); len(id) > 0 {
+		return fmt.Sprintf("%s.%s", resourceType, id)
+	}
+
+	return ""
+}
+
+// Reserve records the resources created by the planned changes for a resource and returns an error, if the remaining quotas are exceeded.
+// A reservation for an existing resource replaces any earlier reservation with the same key, as the changes are planned more than once.
+// The SDK does not expose the address of a new resource, which is why every reservation without a key is counted until it is committed.
+func (c *accountQuotaChecker) Reserve(m interface{}, key string, resources map[string]int) error {
+	if !c.enabled {
+		return nil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(key) > 0 && accountQuotaIsEmpty(resources) {
+		delete(c.planned, key)
+
+		return nil
+	}
+
+	// The quotas are only retrieved once and the usage is afterwards maintained by Commit.
+	if c.quotas == nil {
+		quotas, _, err := dataSourceAccountQuotas(m)
+
+		if err != nil {
+			return err
+		}
+
+		c.quotas = quotas
+	}
+
+	if len(key) > 0 {
+		c.planned[key] = resources
+	} else {
+		for k, v := range resources {
+			c.created[k] += v
+		}
+	}
+
+	return accountQuotaValidate(c.quotas, c.plannedTotal())
+}
+
+// Commit replaces the reservation for a resource with the resources, which have been created.
+func (c *accountQuotaChecker) Commit(key string, resources map[string]int) {
+	if !c.enabled {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(key) > 0 {
+		delete(c.planned, key)
+	}
+
+	for k, v := range resources {
+		if len(key) == 0 {
+			c.created[k] -= v
+
+			if c.created[k] < 0 {
+				c.created[k] = 0
+			}
+		}
+
+		if quota, ok := c.quotas[k]; ok {
+			quota.usage += v
+			c.quotas[k] = quota
+		}
+	}
+}
+
+// plannedTotal returns the sum of the reservations for each type of resource.
+// The caller must hold the mutex.
+func (c *accountQuotaChecker) plannedTotal() map[string]int {
+	total := map[string]int{}
+
+	for k, v := range c.created {
+		total[k] += v
+	}
+
+	for _, resources := range c.planned {
+		for k, v := range resources {
+			total[k] += v
+		}
+	}
+
+	return total
+}
+
+// accountQuotaIsEmpty determines whether a reservation contains no resources.
+func accountQuotaIsEmpty(resources map[string]int) bool {
+	for _, v := range resources {
+		if v > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// accountQuotaValidate returns an error summarizing every quota, which is exceeded by the planned creations.
+func accountQuotaValidate(quotas map[string]accountQuota, planned map[string]int) error {
+	exceeded := []string{}
+
+	for k, v := range planned {
+		quota, ok := quotas[k]
+
+		if !ok || quota.limit == 0 || quota.usage+v <= quota.limit {
+			continue
+		}
+
+		available := quota.limit - quota.usage
+
+		if available < 0 {
+			available = 0
+		}
+
+		exceeded = append(exceeded, fmt.Sprintf("    %s: %d planned, %d of %d available (%d in use)", k, v, available, quota.limit, quota.usage))
+	}
+
+	if len(exceeded) == 0 {
+		return nil
+	}
+
+	sort.Strings(exceeded)
+
+	return fmt.Errorf("Insufficient account quota - Reason: The planned changes exceed the following quotas:\n\n%s", strings.Join(exceeded, "\n"))
+}
@@ -0,0 +1,135 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestAccountQuotaCheckerDisabled tests whether a disabled accountQuotaChecker accepts any number of resources.
+func TestAccountQuotaCheckerDisabled(t *testing.T) {
+	c := newAccountQuotaChecker(false)
+
+	err := c.Reserve(nil, "example", map[string]int{accountQuotaServers: 1000})
+
+	if err != nil {
+		t.Fatalf("Unexpected error returned by a disabled accountQuotaChecker: %s", err.Error())
+	}
+}
+
+// TestAccountQuotaValidate tests whether the exceeded quotas are summarized.
+func TestAccountQuotaValidate(t *testing.T) {
+	quotas := map[string]accountQuota{
+		accountQuotaDisks:       {limit: 0, usage: 100},
+		accountQuotaIPAddresses: {limit: 10, usage: 9},
+		accountQuotaServers:     {limit: 5, usage: 3},
+	}
+
+	err := accountQuotaValidate(quotas, map[string]int{
+		accountQuotaDisks:       50,
+		accountQuotaIPAddresses: 1,
+		accountQuotaServers:     2,
+	})
+
+	if err != nil {
+		t.Fatalf("Unexpected error for planned creations within the quotas: %s", err.Error())
+	}
+
+	err = accountQuotaValidate(quotas, map[string]int{
+		accountQuotaIPAddresses: 2,
+		accountQuotaServers:     3,
+	})
+
+	if err == nil {
+		t.Fatalf("Expected an error for planned creations exceeding the quotas")
+	}
+
+	for _, v := range []string{
+		"IP addresses: 2 planned, 1 of 10 available (9 in use)",
+		"servers: 3 planned, 2 of 5 available (3 in use)",
+	} {
+		if !strings.Contains(err.Error(), v) {
+			t.Fatalf("Expected the error to contain '%s' but got: %s", v, err.Error())
+		}
+	}
+}
+
+// TestAccountQuotaCheckerReserve tests whether repeated reservations for the same existing resource are counted once.
+func TestAccountQuotaCheckerReserve(t *testing.T) {
+	c := newAccountQuotaChecker(true)
+	c.quotas = map[string]accountQuota{
+		accountQuotaDisks: {limit: 3, usage: 1},
+	}
+
+	for i := 0; i < 2; i++ {
+		err := c.Reserve(nil, "clouddk_server.example", map[string]int{accountQuotaDisks: 2})
+
+		if err != nil {
+			t.Fatalf("Repeated reservation for the same resource is counted more than once: %s", err.Error())
+		}
+	}
+
+	err := c.Reserve(nil, "clouddk_server.other", map[string]int{accountQuotaDisks: 1})
+
+	if err == nil {
+		t.Fatalf("Expected an error for reservations exceeding the quotas")
+	}
+
+	c.Commit("clouddk_server.example", map[string]int{accountQuotaDisks: 2})
+
+	if c.quotas[accountQuotaDisks].usage != 3 {
+		t.Fatalf("Expected the committed reservation to be added to the usage but got %d", c.quotas[accountQuotaDisks].usage)
+	}
+}
+
+// TestAccountQuotaCheckerReserveNew tests whether identical new resources are counted once per resource.
+func TestAccountQuotaCheckerReserveNew(t *testing.T) {
+	c := newAccountQuotaChecker(true)
+	c.quotas = map[string]accountQuota{
+		accountQuotaServers: {limit: 2, usage: 1},
+	}
+
+	d := resourceServer().TestResourceData()
+	resources := resourceServerQuotaResources(d)
+
+	if resources[accountQuotaServers] != 1 {
+		t.Fatalf("Expected a new server to reserve 1 server but got %d", resources[accountQuotaServers])
+	}
+
+	err := c.Reserve(nil, accountQuotaKey("clouddk_server", d), resources)
+
+	if err != nil {
+		t.Fatalf("Unexpected error for a server within the quotas: %s", err.Error())
+	}
+
+	err = c.Reserve(nil, accountQuotaKey("clouddk_server", d), resources)
+
+	if err == nil {
+		t.Fatalf("Expected an error for two identical servers exceeding the quotas")
+	}
+
+	c.Commit("", resources)
+	c.Commit("", resources)
+
+	if c.created[accountQuotaServers] != 0 || c.quotas[accountQuotaServers].usage != 3 {
+		t.Fatalf("Expected the committed servers to be moved to the usage but got %d reserved and %d in use", c.created[accountQuotaServers], c.quotas[accountQuotaServers].usage)
+	}
+}
+
+// TestAccountQuotaKey tests whether only existing resources are identified by a key.
+func TestAccountQuotaKey(t *testing.T) {
+	d := resourceDisk().TestResourceData()
+
+	if key := accountQuotaKey("clouddk_disk", d); key != "" {
+		t.Fatalf("Unexpected key for a new disk: %s", key)
+	}
+
+	d.SetId("example")
+
+	if key := accountQuotaKey("clouddk_disk", d); key != "clouddk_disk.example" {
+		t.Fatalf("Unexpected key for an existing disk: %s", key)
+	}
+}
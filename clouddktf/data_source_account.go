@@ -0,0 +1,170 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	dataSourceAccountBalanceKey        = "balance"
+	dataSourceAccountCurrencyKey       = "currency"
+	dataSourceAccountDiskLimitKey      = "disk_limit"
+	dataSourceAccountDiskUsageKey      = "disk_usage"
+	dataSourceAccountIPAddressLimitKey = "ip_address_limit"
+	dataSourceAccountIPAddressUsageKey = "ip_address_usage"
+	dataSourceAccountServerLimitKey    = "server_limit"
+	dataSourceAccountServerUsageKey    = "server_usage"
+)
+
+// dataSourceAccount retrieves information about the account balance and resource quotas.
+func dataSourceAccount() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceAccountBalanceKey: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The account balance",
+			},
+			dataSourceAccountCurrencyKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The currency for the account balance",
+			},
+			dataSourceAccountDiskLimitKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum number of disks (zero means unlimited)",
+			},
+			dataSourceAccountDiskUsageKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of disks in use",
+			},
+			dataSourceAccountIPAddressLimitKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum number of public IP addresses (zero means unlimited)",
+			},
+			dataSourceAccountIPAddressUsageKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of public IP addresses in use",
+			},
+			dataSourceAccountServerLimitKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum number of servers (zero means unlimited)",
+			},
+			dataSourceAccountServerUsageKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of servers in use",
+			},
+		},
+
+		Read: dataSourceAccountRead,
+	}
+}
+
+// dataSourceAccountRead reads information about the account.
+func dataSourceAccountRead(d *schema.ResourceData, m interface{}) error {
+	quotas, account, err := dataSourceAccountQuotas(m)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("account")
+
+	d.Set(dataSourceAccountBalanceKey, float64(account.Balance))
+	d.Set(dataSourceAccountCurrencyKey, account.Currency)
+	d.Set(dataSourceAccountDiskLimitKey, quotas[accountQuotaDisks].limit)
+	d.Set(dataSourceAccountDiskUsageKey, quotas[accountQuotaDisks].usage)
+	d.Set(dataSourceAccountIPAddressLimitKey, quotas[accountQuotaIPAddresses].limit)
+	d.Set(dataSourceAccountIPAddressUsageKey, quotas[accountQuotaIPAddresses].usage)
+	d.Set(dataSourceAccountServerLimitKey, quotas[accountQuotaServers].limit)
+	d.Set(dataSourceAccountServerUsageKey, quotas[accountQuotaServers].usage)
+
+	return nil
+}
+
+// dataSourceAccountQuotas retrieves the account and computes the current usage for each quota.
+func dataSourceAccountQuotas(m interface{}) (map[string]accountQuota, *clouddk.AccountBody, error) {
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", "account", new(bytes.Buffer))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return nil, nil, err
+	} else if res.StatusCode != 200 {
+		return nil, nil, fmt.Errorf("Failed to read the account information - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	account := clouddk.AccountBody{}
+	err = json.NewDecoder(res.Body).Decode(&account)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err = clouddk.GetClientRequestObject(&clientSettings, "GET", "cloudservers?per-page=1000", new(bytes.Buffer))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err = client.Do(req)
+
+	if err != nil {
+		return nil, nil, err
+	} else if res.StatusCode != 200 {
+		return nil, nil, fmt.Errorf("Failed to read the information about the servers - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	servers := make(clouddk.ServerListBody, 0)
+	err = json.NewDecoder(res.Body).Decode(&servers)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dataSourceAccountQuotasFromServers(&account, servers), &account, nil
+}
+
+// dataSourceAccountQuotasFromServers computes the current usage for each quota from the list of servers.
+func dataSourceAccountQuotasFromServers(account *clouddk.AccountBody, servers clouddk.ServerListBody) map[string]accountQuota {
+	disks := 0
+	ipAddresses := 0
+
+	for _, v := range servers {
+		disks += len(v.Disks)
+
+		// Addresses on private networks do not count towards the quota for public IP addresses.
+		for _, ni := range v.NetworkInterfaces {
+			if len(ni.PrivateNetwork) == 0 {
+				ipAddresses += len(ni.IPAddresses)
+			}
+		}
+	}
+
+	return map[string]accountQuota{
+		accountQuotaDisks:       {limit: int(account.Limits.Disks), usage: disks},
+		accountQuotaIPAddresses: {limit: int(account.Limits.IPAddresses), usage: ipAddresses},
+		accountQuotaServers:     {limit: int(account.Limits.CloudServers), usage: len(servers)},
+	}
+}
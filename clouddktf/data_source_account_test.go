@@ -0,0 +1,87 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+)
+
+// TestDataSourceAccountInstantiation tests whether the dataSourceAccount instance can be instantiated.
+func TestDataSourceAccountInstantiation(t *testing.T) {
+	s := dataSourceAccount()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceAccount")
+	}
+}
+
+// TestDataSourceAccountSchema tests the dataSourceAccount schema.
+func TestDataSourceAccountSchema(t *testing.T) {
+	s := dataSourceAccount()
+
+	attributeKeys := []string{
+		dataSourceAccountBalanceKey,
+		dataSourceAccountCurrencyKey,
+		dataSourceAccountDiskLimitKey,
+		dataSourceAccountDiskUsageKey,
+		dataSourceAccountIPAddressLimitKey,
+		dataSourceAccountIPAddressUsageKey,
+		dataSourceAccountServerLimitKey,
+		dataSourceAccountServerUsageKey,
+	}
+
+	for _, v := range attributeKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourceAccount.Schema: Missing attribute \"%s\"", v)
+		}
+
+		if s.Schema[v].Computed != true {
+			t.Fatalf("Error in dataSourceAccount.Schema: Attribute \"%s\" is not computed", v)
+		}
+	}
+}
+
+// TestDataSourceAccountQuotasFromServers tests whether the usage is computed correctly.
+func TestDataSourceAccountQuotasFromServers(t *testing.T) {
+	account := clouddk.AccountBody{
+		Limits: clouddk.AccountLimitsBody{
+			CloudServers: 10,
+			Disks:        20,
+			IPAddresses:  30,
+		},
+	}
+
+	servers := clouddk.ServerListBody{
+		{
+			Disks: clouddk.DiskListBody{{}, {}},
+			NetworkInterfaces: clouddk.NetworkInterfaceListBody{
+				{IPAddresses: clouddk.IPAddressListBody{{}, {}}},
+				{IPAddresses: clouddk.IPAddressListBody{{}}, PrivateNetwork: "example"},
+			},
+		},
+		{
+			Disks: clouddk.DiskListBody{{}},
+			NetworkInterfaces: clouddk.NetworkInterfaceListBody{
+				{IPAddresses: clouddk.IPAddressListBody{{}}},
+			},
+		},
+	}
+
+	quotas := dataSourceAccountQuotasFromServers(&account, servers)
+
+	expected := map[string]accountQuota{
+		accountQuotaDisks:       {limit: 20, usage: 3},
+		accountQuotaIPAddresses: {limit: 30, usage: 3},
+		accountQuotaServers:     {limit: 10, usage: 2},
+	}
+
+	for k, v := range expected {
+		if quotas[k] != v {
+			t.Fatalf("Expected %+v for %s but got %+v", v, k, quotas[k])
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -28,19 +29,24 @@ func dataSourceDisk() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			dataSourceDiskIDKey: {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Description: "The disk identifier",
 				ForceNew:    true,
 			},
 			dataSourceDiskLabelKey: {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The disk label",
+				ForceNew:    true,
 			},
 			dataSourceDiskPrimaryKey: {
 				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Whether the disk is the primary disk",
+				ForceNew:    true,
 			},
 			dataSourceDiskServerIDKey: {
 				Type:        schema.TypeString,
@@ -66,6 +72,10 @@ func dataSourceDiskRead(d *schema.ResourceData, m interface{}) error {
 	diskID := d.Get(dataSourceDiskIDKey).(string)
 	serverID := d.Get(dataSourceDiskServerIDKey).(string)
 
+	if len(diskID) == 0 {
+		return dataSourceDiskLookup(d, m, serverID)
+	}
+
 	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/disks/%s", serverID, diskID), new(bytes.Buffer))
 
 	if err != nil {
@@ -91,6 +101,69 @@ func dataSourceDiskRead(d *schema.ResourceData, m interface{}) error {
 	return dataSourceDiskReadResponseBody(d, m, &disk)
 }
 
+// dataSourceDiskLookup reads information about the server's disk matching the label and primary arguments.
+func dataSourceDiskLookup(d *schema.ResourceData, m interface{}, serverID string) error {
+	criteria := map[string]string{}
+
+	if v, ok := d.GetOk(dataSourceDiskLabelKey); ok {
+		criteria[dataSourceDiskLabelKey] = v.(string)
+	}
+
+	if v, ok := d.GetOk(dataSourceDiskPrimaryKey); ok && v.(bool) {
+		criteria[dataSourceDiskPrimaryKey] = strconv.FormatBool(true)
+	}
+
+	err := dataSourceLookupResult("disk", criteria, 1)
+
+	if err != nil {
+		return err
+	}
+
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/disks", serverID), new(bytes.Buffer))
+
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return err
+	} else if res.StatusCode != 200 {
+		return fmt.Errorf("Failed to read the information about the disks - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	list := make(clouddk.DiskListBody, 0)
+	err = json.NewDecoder(res.Body).Decode(&list)
+
+	if err != nil {
+		return err
+	}
+
+	matches := make(clouddk.DiskListBody, 0, 1)
+
+	for _, v := range list {
+		attributes := map[string]string{
+			dataSourceDiskLabelKey:   v.Label,
+			dataSourceDiskPrimaryKey: strconv.FormatBool(bool(v.Primary)),
+		}
+
+		if dataSourceLookupMatch(criteria, attributes) {
+			matches = append(matches, v)
+		}
+	}
+
+	err = dataSourceLookupResult("disk", criteria, len(matches))
+
+	if err != nil {
+		return err
+	}
+
+	return dataSourceDiskReadResponseBody(d, m, &matches[0])
+}
+
 // dataSourceDiskReadResponseBody parses information about a server's disk.
 func dataSourceDiskReadResponseBody(d *schema.ResourceData, m interface{}, disk *clouddk.DiskBody) error {
 	d.SetId(disk.Identifier)
@@ -101,3 +174,52 @@ func dataSourceDiskReadResponseBody(d *schema.ResourceData, m interface{}, disk
 
 	return nil
 }
+
+// dataSourceDiskElem returns the schema for a disk object.
+func dataSourceDiskElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceDiskIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The disk identifier",
+			},
+			dataSourceDiskLabelKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The disk label",
+			},
+			dataSourceDiskPrimaryKey: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the disk is the primary disk",
+			},
+			dataSourceDiskSizeKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The disk size in gigabytes",
+			},
+		},
+	}
+}
+
+// dataSourceDiskFlatten converts a disk to an object.
+func dataSourceDiskFlatten(disk *clouddk.DiskBody) map[string]interface{} {
+	return map[string]interface{}{
+		dataSourceDiskIDKey:      disk.Identifier,
+		dataSourceDiskLabelKey:   disk.Label,
+		dataSourceDiskPrimaryKey: bool(disk.Primary),
+		dataSourceDiskSizeKey:    int(disk.Size),
+	}
+}
+
+// dataSourceDiskFlattenList converts a list of disks to a list of objects.
+func dataSourceDiskFlattenList(disks clouddk.DiskListBody) []interface{} {
+	list := make([]interface{}, len(disks))
+
+	for i := range disks {
+		list[i] = dataSourceDiskFlatten(&disks[i])
+	}
+
+	return list
+}
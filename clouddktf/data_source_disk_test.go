@@ -22,7 +22,6 @@ func TestDataSourceDiskSchema(t *testing.T) {
 	s := dataSourceDisk()
 
 	idKeys := []string{
-		dataSourceDiskIDKey,
 		dataSourceDiskServerIDKey,
 	}
 
@@ -36,6 +35,22 @@ func TestDataSourceDiskSchema(t *testing.T) {
 		}
 	}
 
+	lookupKeys := []string{
+		dataSourceDiskIDKey,
+		dataSourceDiskLabelKey,
+		dataSourceDiskPrimaryKey,
+	}
+
+	for _, v := range lookupKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourceDisk.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Optional != true || s.Schema[v].Computed != true {
+			t.Fatalf("Error in dataSourceDisk.Schema: Argument \"%s\" is not optional and computed", v)
+		}
+	}
+
 	attributeKeys := []string{
 		dataSourceDiskLabelKey,
 		dataSourceDiskPrimaryKey,
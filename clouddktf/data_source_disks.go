@@ -9,23 +9,42 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 const (
+	dataSourceDisksFilterKey  = "filter"
 	dataSourceDisksIDKey      = "id"
+	dataSourceDisksDisksKey   = "disks"
 	dataSourceDisksIdsKey     = "ids"
 	dataSourceDisksLabelsKey  = "labels"
 	dataSourceDisksPrimaryKey = "primary"
 	dataSourceDisksSizesKey   = "sizes"
 )
 
+var (
+	dataSourceDisksFilterNames = []string{
+		dataSourceDiskIDKey,
+		dataSourceDiskLabelKey,
+		dataSourceDiskPrimaryKey,
+		dataSourceDiskSizeKey,
+	}
+)
+
 // dataSourceDisks retrieves information about a server's disks.
 func dataSourceDisks() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			dataSourceDisksDisksKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server's disks",
+				Elem:        dataSourceDiskElem(),
+			},
+			dataSourceDisksFilterKey: dataSourceFilterSchema(nil),
 			dataSourceDisksIDKey: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -64,6 +83,12 @@ func dataSourceDisks() *schema.Resource {
 
 // dataSourceDisksRead reads information about a server's disks.
 func dataSourceDisksRead(d *schema.ResourceData, m interface{}) error {
+	filters, err := dataSourceFilterRead(d, dataSourceDisksFilterNames, nil)
+
+	if err != nil {
+		return err
+	}
+
 	clientSettings := m.(clouddk.ClientSettings)
 
 	id := d.Get(dataSourceDisksIDKey).(string)
@@ -82,13 +107,28 @@ func dataSourceDisksRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Failed to read the information about the disks - Reason: The API responded with HTTP %s", res.Status)
 	}
 
-	disks := clouddk.DiskListBody{}
-	err = json.NewDecoder(res.Body).Decode(&disks)
+	unfilteredDisks := clouddk.DiskListBody{}
+	err = json.NewDecoder(res.Body).Decode(&unfilteredDisks)
 
 	if err != nil {
 		return err
 	}
 
+	disks := make(clouddk.DiskListBody, 0, len(unfilteredDisks))
+
+	for _, v := range unfilteredDisks {
+		attributes := map[string]string{
+			dataSourceDiskIDKey:      v.Identifier,
+			dataSourceDiskLabelKey:   v.Label,
+			dataSourceDiskPrimaryKey: strconv.FormatBool(bool(v.Primary)),
+			dataSourceDiskSizeKey:    strconv.Itoa(int(v.Size)),
+		}
+
+		if dataSourceFilterMatch(filters, attributes) {
+			disks = append(disks, v)
+		}
+	}
+
 	diskIds := make([]interface{}, len(disks))
 	diskLabels := make([]interface{}, len(disks))
 	diskPrimary := make([]interface{}, len(disks))
@@ -103,6 +143,7 @@ func dataSourceDisksRead(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(id)
 
+	d.Set(dataSourceDisksDisksKey, dataSourceDiskFlattenList(disks))
 	d.Set(dataSourceDisksIdsKey, diskIds)
 	d.Set(dataSourceDisksLabelsKey, diskLabels)
 	d.Set(dataSourceDisksPrimaryKey, diskPrimary)
@@ -30,6 +30,7 @@ func TestDataSourceDisksSchema(t *testing.T) {
 	}
 
 	attributeKeys := []string{
+		dataSourceDisksDisksKey,
 		dataSourceDisksIdsKey,
 		dataSourceDisksLabelsKey,
 		dataSourceDisksPrimaryKey,
@@ -0,0 +1,181 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	dataSourceFilterKey       = "filter"
+	dataSourceFilterNameKey   = "name"
+	dataSourceFilterRegexKey  = "regex"
+	dataSourceFilterValuesKey = "values"
+)
+
+// dataSourceFilter describes a filter for a list data source.
+type dataSourceFilter struct {
+	Name    string
+	Regex   bool
+	Values  []string
+	Regexps []*regexp.Regexp
+}
+
+// dataSourceFilterSchema returns the schema for the filter blocks supported by list data sources.
+// The name and values arguments become optional when legacy arguments are specified.
+func dataSourceFilterSchema(legacy map[string]*schema.Schema) *schema.Schema {
+	s := map[string]*schema.Schema{
+		dataSourceFilterNameKey: {
+			Type:        schema.TypeString,
+			Required:    legacy == nil,
+			Optional:    legacy != nil,
+			Description: "The name of the attribute to filter by",
+		},
+		dataSourceFilterRegexKey: {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the values are regular expressions",
+		},
+		dataSourceFilterValuesKey: {
+			Type:        schema.TypeList,
+			Required:    legacy == nil,
+			Optional:    legacy != nil,
+			Description: "The values to match (any value may match)",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	for k, v := range legacy {
+		s[k] = v
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+// dataSourceFilterRead parses the filter blocks for a list data source.
+// Blocks without values are only accepted, if one of the legacy keys has a value, as they are handled by the individual data sources.
+func dataSourceFilterRead(d *schema.ResourceData, names []string, legacyKeys []string) ([]dataSourceFilter, error) {
+	blocks := d.Get(dataSourceFilterKey).([]interface{})
+	filters := make([]dataSourceFilter, 0, len(blocks))
+
+	for _, b := range blocks {
+		if b == nil {
+			continue
+		}
+
+		block := b.(map[string]interface{})
+		name := block[dataSourceFilterNameKey].(string)
+		values := block[dataSourceFilterValuesKey].([]interface{})
+
+		if len(values) == 0 {
+			if dataSourceFilterIsLegacy(block, legacyKeys) {
+				continue
+			}
+
+			return nil, fmt.Errorf("The filter '%s' must specify at least one value", name)
+		}
+
+		if !dataSourceFilterIsValidName(name, names) {
+			return nil, fmt.Errorf("Invalid filter name '%s' (must be one of: %s)", name, strings.Join(names, ", "))
+		}
+
+		filter := dataSourceFilter{
+			Name:    name,
+			Regex:   block[dataSourceFilterRegexKey].(bool),
+			Values:  make([]string, len(values)),
+			Regexps: make([]*regexp.Regexp, len(values)),
+		}
+
+		for i, v := range values {
+			if v != nil {
+				filter.Values[i] = v.(string)
+			}
+
+			if filter.Regex {
+				r, err := regexp.Compile(filter.Values[i])
+
+				if err != nil {
+					return nil, fmt.Errorf("Invalid regular expression '%s' for filter '%s' - Reason: %s", filter.Values[i], name, err.Error())
+				}
+
+				filter.Regexps[i] = r
+			}
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// dataSourceFilterIsLegacy determines whether a block is a legacy filter.
+func dataSourceFilterIsLegacy(block map[string]interface{}, legacyKeys []string) bool {
+	for _, k := range legacyKeys {
+		if v, ok := block[k].(string); ok && len(v) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dataSourceFilterIsValidName determines whether a filter name is supported.
+func dataSourceFilterIsValidName(name string, names []string) bool {
+	for _, v := range names {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dataSourceFilterMatch determines whether the attributes of an object satisfy all the filters.
+func dataSourceFilterMatch(filters []dataSourceFilter, attributes map[string]string) bool {
+	for _, f := range filters {
+		value := attributes[f.Name]
+		matched := false
+
+		for i, v := range f.Values {
+			if f.Regex {
+				matched = f.Regexps[i].MatchString(value)
+			} else {
+				matched = v == value
+			}
+
+			if matched {
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dataSourceFilterServerSideValue returns the value for an exact match filter, which can be passed on to the API.
+func dataSourceFilterServerSideValue(filters []dataSourceFilter, name string) string {
+	for _, f := range filters {
+		if f.Name == name && !f.Regex && len(f.Values) == 1 {
+			return f.Values[0]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,114 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// TestDataSourceFilterSchema tests the filter schema for all list data sources.
+func TestDataSourceFilterSchema(t *testing.T) {
+	dataSources := map[string]*schema.Resource{
+		"dataSourceDisks":             dataSourceDisks(),
+		"dataSourceFirewallRules":     dataSourceFirewallRules(),
+		"dataSourceIPAddresses":       dataSourceIPAddresses(),
+		"dataSourceLocations":         dataSourceLocations(),
+		"dataSourceNetworkInterfaces": dataSourceNetworkInterfaces(),
+		"dataSourcePackages":          dataSourcePackages(),
+		"dataSourceServerBackups":     dataSourceServerBackups(),
+		"dataSourceServers":           dataSourceServers(),
+		"dataSourceTemplates":         dataSourceTemplates(),
+	}
+
+	for name, s := range dataSources {
+		if s.Schema[dataSourceFilterKey] == nil {
+			t.Fatalf("Error in %s.Schema: Missing block \"%s\"", name, dataSourceFilterKey)
+		}
+
+		if s.Schema[dataSourceFilterKey].Optional != true {
+			t.Fatalf("Error in %s.Schema: Block \"%s\" is not optional", name, dataSourceFilterKey)
+		}
+
+		blockElement, blockElementCasted := s.Schema[dataSourceFilterKey].Elem.(*schema.Resource)
+
+		if !blockElementCasted {
+			t.Fatalf("Error in %s.Schema: Element for block \"%s\" is not a pointer to schema.Resource", name, dataSourceFilterKey)
+		}
+
+		blockKeys := []string{
+			dataSourceFilterNameKey,
+			dataSourceFilterRegexKey,
+			dataSourceFilterValuesKey,
+		}
+
+		for _, v := range blockKeys {
+			if blockElement.Schema[v] == nil {
+				t.Fatalf("Error in %s.Schema.%s: Missing argument \"%s\"", name, dataSourceFilterKey, v)
+			}
+		}
+	}
+}
+
+// TestDataSourceFilterMatch tests the matching logic for filters.
+func TestDataSourceFilterMatch(t *testing.T) {
+	filters := []dataSourceFilter{
+		{
+			Name:   "hostname",
+			Values: []string{"web-1", "web-2"},
+		},
+		{
+			Name:    "location_id",
+			Regex:   true,
+			Values:  []string{"^dk"},
+			Regexps: []*regexp.Regexp{regexp.MustCompile("^dk")},
+		},
+	}
+
+	if !dataSourceFilterMatch(filters, map[string]string{"hostname": "web-2", "location_id": "dk1"}) {
+		t.Fatalf("Expected the attributes to match the filters")
+	}
+
+	if dataSourceFilterMatch(filters, map[string]string{"hostname": "web-3", "location_id": "dk1"}) {
+		t.Fatalf("Expected the attributes not to match the exact value filter")
+	}
+
+	if dataSourceFilterMatch(filters, map[string]string{"hostname": "web-1", "location_id": "se1"}) {
+		t.Fatalf("Expected the attributes not to match the regular expression filter")
+	}
+
+	if !dataSourceFilterMatch(nil, map[string]string{"hostname": "web-3"}) {
+		t.Fatalf("Expected the attributes to match when no filters are defined")
+	}
+}
+
+// TestDataSourceFilterReadLegacy tests whether blocks without values are only accepted as legacy filters.
+func TestDataSourceFilterReadLegacy(t *testing.T) {
+	legacy := dataSourceServers().TestResourceData()
+	legacy.Set(dataSourceFilterKey, []interface{}{
+		map[string]interface{}{dataSourceServersFilterHostnameKey: "web"},
+	})
+
+	filters, err := dataSourceFilterRead(legacy, dataSourceServersFilterNames, []string{dataSourceServersFilterHostnameKey})
+
+	if err != nil {
+		t.Fatalf("Unexpected error for a legacy filter: %s", err.Error())
+	} else if len(filters) != 0 {
+		t.Fatalf("Expected the legacy filter to be skipped but got %d filters", len(filters))
+	}
+
+	invalid := dataSourceServers().TestResourceData()
+	invalid.Set(dataSourceFilterKey, []interface{}{
+		map[string]interface{}{dataSourceFilterNameKey: dataSourceServerLabelKey},
+	})
+
+	_, err = dataSourceFilterRead(invalid, dataSourceServersFilterNames, []string{dataSourceServersFilterHostnameKey})
+
+	if err == nil {
+		t.Fatalf("Expected an error for a filter without values")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -120,3 +121,65 @@ func dataSourceFirewallRuleReadResponseBody(d *schema.ResourceData, m interface{
 
 	return nil
 }
+
+// dataSourceFirewallRuleElem returns the schema for a firewall rule object.
+func dataSourceFirewallRuleElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceFirewallRuleAddressKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CIDR block for the firewall rule",
+			},
+			dataSourceFirewallRuleCommandKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The command for the firewall rule",
+			},
+			dataSourceFirewallRuleIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The firewall rule identifier",
+			},
+			dataSourceFirewallRulePortKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The port for the firewall rule",
+			},
+			dataSourceFirewallRuleProtocolKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The protocol for the firewall rule",
+			},
+		},
+	}
+}
+
+// dataSourceFirewallRuleFlatten converts a firewall rule to an object.
+func dataSourceFirewallRuleFlatten(firewallRule *clouddk.FirewallRuleBody) map[string]interface{} {
+	return map[string]interface{}{
+		dataSourceFirewallRuleAddressKey:  fmt.Sprintf("%s/%d", firewallRule.Address, firewallRule.Bits),
+		dataSourceFirewallRuleCommandKey:  firewallRule.Command,
+		dataSourceFirewallRuleIDKey:       firewallRule.Identifier,
+		dataSourceFirewallRulePortKey:     firewallRule.Port,
+		dataSourceFirewallRuleProtocolKey: firewallRule.Protocol,
+	}
+}
+
+// dataSourceFirewallRuleFlattenList converts a list of firewall rules to a list of objects ordered by position.
+func dataSourceFirewallRuleFlattenList(firewallRules clouddk.FirewallRuleListBody) []interface{} {
+	sortedFirewallRules := make(clouddk.FirewallRuleListBody, len(firewallRules))
+	copy(sortedFirewallRules, firewallRules)
+
+	sort.SliceStable(sortedFirewallRules, func(i, j int) bool {
+		return sortedFirewallRules[i].Position < sortedFirewallRules[j].Position
+	})
+
+	list := make([]interface{}, len(sortedFirewallRules))
+
+	for i := range sortedFirewallRules {
+		list[i] = dataSourceFirewallRuleFlatten(&sortedFirewallRules[i])
+	}
+
+	return list
+}
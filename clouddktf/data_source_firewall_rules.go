@@ -9,19 +9,32 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 const (
-	dataSourceFirewallRulesAddressesKey = "addresses"
-	dataSourceFirewallRulesCommandsKey  = "commands"
-	dataSourceFirewallRulesIDKey        = "id"
-	dataSourceFirewallRulesIdsKey       = "ids"
-	dataSourceFirewallRulesPortsKey     = "ports"
-	dataSourceFirewallRulesProtocolsKey = "protocols"
-	dataSourceFirewallRulesServerIDKey  = "server_id"
+	dataSourceFirewallRulesAddressesKey     = "addresses"
+	dataSourceFirewallRulesCommandsKey      = "commands"
+	dataSourceFirewallRulesFilterKey        = "filter"
+	dataSourceFirewallRulesFirewallRulesKey = "firewall_rules"
+	dataSourceFirewallRulesIDKey            = "id"
+	dataSourceFirewallRulesIdsKey           = "ids"
+	dataSourceFirewallRulesPortsKey         = "ports"
+	dataSourceFirewallRulesProtocolsKey     = "protocols"
+	dataSourceFirewallRulesServerIDKey      = "server_id"
+)
+
+var (
+	dataSourceFirewallRulesFilterNames = []string{
+		dataSourceFirewallRuleAddressKey,
+		dataSourceFirewallRuleCommandKey,
+		dataSourceFirewallRuleIDKey,
+		dataSourceFirewallRulePortKey,
+		dataSourceFirewallRuleProtocolKey,
+	}
 )
 
 // dataSourceFirewallRules retrieves information about firewall rules for a network interface.
@@ -40,6 +53,13 @@ func dataSourceFirewallRules() *schema.Resource {
 				Description: "The commands for the firewall rules assigned to the server's network interfaces",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			dataSourceFirewallRulesFilterKey: dataSourceFilterSchema(nil),
+			dataSourceFirewallRulesFirewallRulesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The firewall rules assigned to the network interface",
+				Elem:        dataSourceFirewallRuleElem(),
+			},
 			dataSourceFirewallRulesIDKey: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -78,6 +98,12 @@ func dataSourceFirewallRules() *schema.Resource {
 
 // dataSourceFirewallRulesRead reads information about firewall rules for a network interface.
 func dataSourceFirewallRulesRead(d *schema.ResourceData, m interface{}) error {
+	filters, err := dataSourceFilterRead(d, dataSourceFirewallRulesFilterNames, nil)
+
+	if err != nil {
+		return err
+	}
+
 	clientSettings := m.(clouddk.ClientSettings)
 
 	networkInterfaceID := d.Get(dataSourceFirewallRulesIDKey).(string)
@@ -98,31 +124,53 @@ func dataSourceFirewallRulesRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Failed to read the information about the firewall rules - Reason: The API responded with HTTP %s", res.Status)
 	}
 
-	firewallRules := clouddk.FirewallRuleListBody{}
-	err = json.NewDecoder(res.Body).Decode(&firewallRules)
+	unfilteredFirewallRules := clouddk.FirewallRuleListBody{}
+	err = json.NewDecoder(res.Body).Decode(&unfilteredFirewallRules)
 
 	if err != nil {
 		return err
 	}
 
+	firewallRules := make(clouddk.FirewallRuleListBody, 0, len(unfilteredFirewallRules))
+
+	for _, v := range unfilteredFirewallRules {
+		attributes := map[string]string{
+			dataSourceFirewallRuleAddressKey:  fmt.Sprintf("%s/%d", v.Address, v.Bits),
+			dataSourceFirewallRuleCommandKey:  v.Command,
+			dataSourceFirewallRuleIDKey:       v.Identifier,
+			dataSourceFirewallRulePortKey:     v.Port,
+			dataSourceFirewallRuleProtocolKey: v.Protocol,
+		}
+
+		if dataSourceFilterMatch(filters, attributes) {
+			firewallRules = append(firewallRules, v)
+		}
+	}
+
 	firewallRulesAddresses := make([]interface{}, len(firewallRules))
 	firewallRulesCommands := make([]interface{}, len(firewallRules))
 	firewallRulesIds := make([]interface{}, len(firewallRules))
 	firewallRulesPorts := make([]interface{}, len(firewallRules))
 	firewallRulesProtocols := make([]interface{}, len(firewallRules))
 
-	for _, v := range firewallRules {
-		firewallRulesAddresses[v.Position-1] = fmt.Sprintf("%s/%d", v.Address, v.Bits)
-		firewallRulesCommands[v.Position-1] = v.Command
-		firewallRulesIds[v.Position-1] = v.Identifier
-		firewallRulesPorts[v.Position-1] = v.Port
-		firewallRulesProtocols[v.Position-1] = v.Protocol
+	// The rules must be sorted by position as filters may have removed some of them.
+	sort.SliceStable(firewallRules, func(i, j int) bool {
+		return firewallRules[i].Position < firewallRules[j].Position
+	})
+
+	for i, v := range firewallRules {
+		firewallRulesAddresses[i] = fmt.Sprintf("%s/%d", v.Address, v.Bits)
+		firewallRulesCommands[i] = v.Command
+		firewallRulesIds[i] = v.Identifier
+		firewallRulesPorts[i] = v.Port
+		firewallRulesProtocols[i] = v.Protocol
 	}
 
 	d.SetId(networkInterfaceID)
 
 	d.Set(dataSourceFirewallRulesAddressesKey, firewallRulesAddresses)
 	d.Set(dataSourceFirewallRulesCommandsKey, firewallRulesCommands)
+	d.Set(dataSourceFirewallRulesFirewallRulesKey, dataSourceFirewallRuleFlattenList(firewallRules))
 	d.Set(dataSourceFirewallRulesIdsKey, firewallRulesIds)
 	d.Set(dataSourceFirewallRulesPortsKey, firewallRulesPorts)
 	d.Set(dataSourceFirewallRulesProtocolsKey, firewallRulesProtocols)
@@ -39,6 +39,7 @@ func TestDataSourceFirewallRulesSchema(t *testing.T) {
 	attributeKeys := []string{
 		dataSourceFirewallRulesAddressesKey,
 		dataSourceFirewallRulesCommandsKey,
+		dataSourceFirewallRulesFirewallRulesKey,
 		dataSourceFirewallRulesIdsKey,
 		dataSourceFirewallRulesPortsKey,
 		dataSourceFirewallRulesProtocolsKey,
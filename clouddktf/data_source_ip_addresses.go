@@ -16,13 +16,25 @@ import (
 
 const (
 	dataSourceIPAddressesAddressesKey           = "addresses"
+	dataSourceIPAddressesFilterKey              = "filter"
 	dataSourceIPAddressesGatewaysKey            = "gateways"
 	dataSourceIPAddressesIDKey                  = "id"
+	dataSourceIPAddressesIPAddressesKey         = "ip_addresses"
 	dataSourceIPAddressesNetmasksKey            = "netmasks"
 	dataSourceIPAddressesNetworkInterfaceIdsKey = "network_interface_ids"
 	dataSourceIPAddressesNetworksKey            = "networks"
 )
 
+var (
+	dataSourceIPAddressesFilterNames = []string{
+		resourceIPAddressAddressKey,
+		resourceIPAddressGatewayKey,
+		resourceIPAddressNetmaskKey,
+		resourceIPAddressNetworkKey,
+		resourceIPAddressNetworkInterfaceIDKey,
+	}
+)
+
 // dataSourceIPAddresses retrieves information about IP addresses.
 func dataSourceIPAddresses() *schema.Resource {
 	return &schema.Resource{
@@ -33,6 +45,7 @@ func dataSourceIPAddresses() *schema.Resource {
 				Description: "The IP addresses assigned to the server's network interfaces",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			dataSourceIPAddressesFilterKey: dataSourceFilterSchema(nil),
 			dataSourceIPAddressesGatewaysKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -45,6 +58,12 @@ func dataSourceIPAddresses() *schema.Resource {
 				Description: "The server identifier",
 				ForceNew:    true,
 			},
+			dataSourceIPAddressesIPAddressesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IP addresses assigned to the server's network interfaces",
+				Elem:        dataSourceIPAddressElem(),
+			},
 			dataSourceIPAddressesNetmasksKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -71,6 +90,12 @@ func dataSourceIPAddresses() *schema.Resource {
 
 // dataSourceIPAddressesRead reads information about IP addresses.
 func dataSourceIPAddressesRead(d *schema.ResourceData, m interface{}) error {
+	filters, err := dataSourceFilterRead(d, dataSourceIPAddressesFilterNames, nil)
+
+	if err != nil {
+		return err
+	}
+
 	clientSettings := m.(clouddk.ClientSettings)
 
 	id := d.Get(dataSourceIPAddressesIDKey).(string)
@@ -89,13 +114,29 @@ func dataSourceIPAddressesRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Failed to read the information about the IP addresses - Reason: The API responded with HTTP %s", res.Status)
 	}
 
-	ipAddresses := clouddk.IPAddressListBody{}
-	err = json.NewDecoder(res.Body).Decode(&ipAddresses)
+	unfilteredIpAddresses := clouddk.IPAddressListBody{}
+	err = json.NewDecoder(res.Body).Decode(&unfilteredIpAddresses)
 
 	if err != nil {
 		return err
 	}
 
+	ipAddresses := make(clouddk.IPAddressListBody, 0, len(unfilteredIpAddresses))
+
+	for _, v := range unfilteredIpAddresses {
+		attributes := map[string]string{
+			resourceIPAddressAddressKey:            v.Address,
+			resourceIPAddressGatewayKey:            v.Gateway,
+			resourceIPAddressNetmaskKey:            v.Netmask,
+			resourceIPAddressNetworkKey:            v.Network,
+			resourceIPAddressNetworkInterfaceIDKey: v.NetworkInterfaceIdentifier,
+		}
+
+		if dataSourceFilterMatch(filters, attributes) {
+			ipAddresses = append(ipAddresses, v)
+		}
+	}
+
 	addresses := make([]interface{}, len(ipAddresses))
 	gateways := make([]interface{}, len(ipAddresses))
 	netmasks := make([]interface{}, len(ipAddresses))
@@ -114,9 +155,71 @@ func dataSourceIPAddressesRead(d *schema.ResourceData, m interface{}) error {
 
 	d.Set(dataSourceIPAddressesAddressesKey, addresses)
 	d.Set(dataSourceIPAddressesGatewaysKey, gateways)
+	d.Set(dataSourceIPAddressesIPAddressesKey, dataSourceIPAddressFlattenList(ipAddresses))
 	d.Set(dataSourceIPAddressesNetmasksKey, netmasks)
 	d.Set(dataSourceIPAddressesNetworkInterfaceIdsKey, networkInterfaceIds)
 	d.Set(dataSourceIPAddressesNetworksKey, networks)
 
 	return nil
 }
+
+// dataSourceIPAddressElem returns the schema for an IP address object.
+func dataSourceIPAddressElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			resourceIPAddressAddressKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IP address",
+			},
+			resourceIPAddressGatewayKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The gateway address",
+			},
+			resourceIPAddressNetmaskKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The netmask",
+			},
+			resourceIPAddressNetworkKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network address",
+			},
+			resourceIPAddressNetworkInterfaceIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network interface identifier",
+			},
+			resourceIPAddressReverseDNSKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reverse DNS record (PTR)",
+			},
+		},
+	}
+}
+
+// dataSourceIPAddressFlatten converts an IP address to an object.
+func dataSourceIPAddressFlatten(ipAddress *clouddk.IPAddressBody) map[string]interface{} {
+	return map[string]interface{}{
+		resourceIPAddressAddressKey:            ipAddress.Address,
+		resourceIPAddressGatewayKey:            ipAddress.Gateway,
+		resourceIPAddressNetmaskKey:            ipAddress.Netmask,
+		resourceIPAddressNetworkKey:            ipAddress.Network,
+		resourceIPAddressNetworkInterfaceIDKey: ipAddress.NetworkInterfaceIdentifier,
+		resourceIPAddressReverseDNSKey:         ipAddress.ReverseDNS,
+	}
+}
+
+// dataSourceIPAddressFlattenList converts a list of IP addresses to a list of objects.
+func dataSourceIPAddressFlattenList(ipAddresses clouddk.IPAddressListBody) []interface{} {
+	list := make([]interface{}, len(ipAddresses))
+
+	for i := range ipAddresses {
+		list[i] = dataSourceIPAddressFlatten(&ipAddresses[i])
+	}
+
+	return list
+}
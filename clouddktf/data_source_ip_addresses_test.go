@@ -32,6 +32,7 @@ func TestDataSourceIPAddressesSchema(t *testing.T) {
 	attributeKeys := []string{
 		dataSourceIPAddressesAddressesKey,
 		dataSourceIPAddressesGatewaysKey,
+		dataSourceIPAddressesIPAddressesKey,
 		dataSourceIPAddressesNetmasksKey,
 		dataSourceIPAddressesNetworkInterfaceIdsKey,
 		dataSourceIPAddressesNetworksKey,
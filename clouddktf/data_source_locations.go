@@ -15,14 +15,32 @@ import (
 )
 
 const (
-	dataSourceLocationsIdsKey   = "ids"
-	dataSourceLocationsNamesKey = "names"
+	dataSourceLocationsFilterKey              = "filter"
+	dataSourceLocationsIdsKey                 = "ids"
+	dataSourceLocationsLocationCityKey        = "city"
+	dataSourceLocationsLocationCountryKey     = "country"
+	dataSourceLocationsLocationIDKey          = "id"
+	dataSourceLocationsLocationNameKey        = "name"
+	dataSourceLocationsLocationPackageIDsKey  = "package_ids"
+	dataSourceLocationsLocationTemplateIDsKey = "template_ids"
+	dataSourceLocationsLocationsKey           = "locations"
+	dataSourceLocationsNamesKey               = "names"
+)
+
+var (
+	dataSourceLocationsFilterNames = []string{
+		dataSourceLocationsLocationCityKey,
+		dataSourceLocationsLocationCountryKey,
+		dataSourceLocationsLocationIDKey,
+		dataSourceLocationsLocationNameKey,
+	}
 )
 
 // dataSourceLocations retrieves a list of datacenter locations.
 func dataSourceLocations() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			dataSourceLocationsFilterKey: dataSourceFilterSchema(nil),
 			dataSourceLocationsIdsKey: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -33,6 +51,12 @@ func dataSourceLocations() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			dataSourceLocationsLocationsKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The locations",
+				Elem:        dataSourceLocationElem(),
+			},
 		},
 
 		Read: dataSourceLocationsRead,
@@ -41,41 +65,140 @@ func dataSourceLocations() *schema.Resource {
 
 // dataSourceLocationsRead reads information about datacenter locations.
 func dataSourceLocationsRead(d *schema.ResourceData, m interface{}) error {
-	clientSettings := m.(clouddk.ClientSettings)
-	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", "locations", new(bytes.Buffer))
+	filters, err := dataSourceFilterRead(d, dataSourceLocationsFilterNames, nil)
 
 	if err != nil {
 		return err
 	}
 
-	client := &http.Client{}
-	res, err := client.Do(req)
+	unfilteredList, err := dataSourceLocationsList(m)
 
 	if err != nil {
 		return err
-	} else if res.StatusCode != 200 {
-		return fmt.Errorf("Failed to read the information about the locations - Reason: The API responded with HTTP %s", res.Status)
 	}
 
-	list := make(clouddk.LocationListBody, 0)
-	err = json.NewDecoder(res.Body).Decode(&list)
+	list := make(clouddk.LocationListBody, 0, len(unfilteredList))
 
-	if err != nil {
-		return err
+	for _, v := range unfilteredList {
+		attributes := map[string]string{
+			dataSourceLocationsLocationCityKey:    v.City,
+			dataSourceLocationsLocationCountryKey: v.Country,
+			dataSourceLocationsLocationIDKey:      v.Identifier,
+			dataSourceLocationsLocationNameKey:    v.Name,
+		}
+
+		if dataSourceFilterMatch(filters, attributes) {
+			list = append(list, v)
+		}
 	}
 
 	ids := make([]interface{}, len(list))
 	names := make([]interface{}, len(list))
+	locations := make([]interface{}, len(list))
 
 	for i, v := range list {
 		ids[i] = v.Identifier
 		names[i] = v.Name
+		locations[i] = dataSourceLocationFlatten(&list[i])
 	}
 
 	d.SetId("locations")
 
 	d.Set(dataSourceLocationsIdsKey, ids)
 	d.Set(dataSourceLocationsNamesKey, names)
+	d.Set(dataSourceLocationsLocationsKey, locations)
 
 	return nil
 }
+
+// dataSourceLocationsList retrieves the list of datacenter locations.
+func dataSourceLocationsList(m interface{}) (clouddk.LocationListBody, error) {
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", "locations", new(bytes.Buffer))
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode != 200 {
+		return nil, fmt.Errorf("Failed to read the information about the locations - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	list := make(clouddk.LocationListBody, 0)
+	err = json.NewDecoder(res.Body).Decode(&list)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// dataSourceLocationElem returns the schema for a location object.
+func dataSourceLocationElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceLocationsLocationCityKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The city",
+			},
+			dataSourceLocationsLocationCountryKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The country",
+			},
+			dataSourceLocationsLocationIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The location identifier",
+			},
+			dataSourceLocationsLocationNameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The location name",
+			},
+			dataSourceLocationsLocationPackageIDsKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The identifiers of the packages available in the location",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			dataSourceLocationsLocationTemplateIDsKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The identifiers of the templates available in the location",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// dataSourceLocationFlatten converts a location to an object.
+func dataSourceLocationFlatten(location *clouddk.LocationBody) map[string]interface{} {
+	packageIDs := make([]interface{}, len(location.Packages))
+
+	for i, v := range location.Packages {
+		packageIDs[i] = v.Identifier
+	}
+
+	templateIDs := make([]interface{}, len(location.Templates))
+
+	for i, v := range location.Templates {
+		templateIDs[i] = v.Identifier
+	}
+
+	return map[string]interface{}{
+		dataSourceLocationsLocationCityKey:        location.City,
+		dataSourceLocationsLocationCountryKey:     location.Country,
+		dataSourceLocationsLocationIDKey:          location.Identifier,
+		dataSourceLocationsLocationNameKey:        location.Name,
+		dataSourceLocationsLocationPackageIDsKey:  packageIDs,
+		dataSourceLocationsLocationTemplateIDsKey: templateIDs,
+	}
+}
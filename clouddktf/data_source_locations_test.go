@@ -23,6 +23,7 @@ func TestDataSourceLocationsSchema(t *testing.T) {
 
 	attributeKeys := []string{
 		dataSourceLocationsIdsKey,
+		dataSourceLocationsLocationsKey,
 		dataSourceLocationsNamesKey,
 	}
 
@@ -36,3 +37,27 @@ func TestDataSourceLocationsSchema(t *testing.T) {
 		}
 	}
 }
+
+// TestDataSourceLocationElem tests the dataSourceLocationElem schema.
+func TestDataSourceLocationElem(t *testing.T) {
+	s := dataSourceLocationElem()
+
+	attributeKeys := []string{
+		dataSourceLocationsLocationCityKey,
+		dataSourceLocationsLocationCountryKey,
+		dataSourceLocationsLocationIDKey,
+		dataSourceLocationsLocationNameKey,
+		dataSourceLocationsLocationPackageIDsKey,
+		dataSourceLocationsLocationTemplateIDsKey,
+	}
+
+	for _, v := range attributeKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourceLocationElem.Schema: Missing attribute \"%s\"", v)
+		}
+
+		if s.Schema[v].Computed != true {
+			t.Fatalf("Error in dataSourceLocationElem.Schema: Attribute \"%s\" is not computed", v)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// dataSourceLookupDescribe returns a human readable description of the criteria for a lookup.
+func dataSourceLookupDescribe(criteria map[string]string) string {
+	keys := make([]string, 0, len(criteria))
+
+	for k := range criteria {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	conditions := make([]string, len(keys))
+
+	for i, k := range keys {
+		conditions[i] = fmt.Sprintf("%s = %q", k, criteria[k])
+	}
+
+	return strings.Join(conditions, ", ")
+}
+
+// dataSourceLookupMatch determines whether the attributes of an object satisfy all the lookup criteria.
+func dataSourceLookupMatch(criteria map[string]string, attributes map[string]string) bool {
+	for k, v := range criteria {
+		if attributes[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dataSourceLookupResult returns an error unless exactly one object matched the lookup criteria.
+func dataSourceLookupResult(objectType string, criteria map[string]string, count int) error {
+	if len(criteria) == 0 {
+		return fmt.Errorf("Cannot look up the %s without an identifier or any lookup arguments", objectType)
+	}
+
+	if count != 1 {
+		return fmt.Errorf("Expected exactly one %s matching the lookup criteria (%s) but found %d", objectType, dataSourceLookupDescribe(criteria), count)
+	}
+
+	return nil
+}
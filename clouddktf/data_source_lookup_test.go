@@ -0,0 +1,54 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+)
+
+// TestDataSourceLookupMatch tests the dataSourceLookupMatch function.
+func TestDataSourceLookupMatch(t *testing.T) {
+	attributes := map[string]string{
+		dataSourceServerHostnameKey: "web-1.example.com",
+		dataSourceServerLabelKey:    "Web 1",
+	}
+
+	if !dataSourceLookupMatch(map[string]string{}, attributes) {
+		t.Fatalf("Error in dataSourceLookupMatch: Empty criteria do not match")
+	}
+
+	if !dataSourceLookupMatch(map[string]string{dataSourceServerHostnameKey: "web-1.example.com"}, attributes) {
+		t.Fatalf("Error in dataSourceLookupMatch: Exact hostname does not match")
+	}
+
+	if dataSourceLookupMatch(map[string]string{dataSourceServerHostnameKey: "web-1"}, attributes) {
+		t.Fatalf("Error in dataSourceLookupMatch: Partial hostname matches")
+	}
+
+	if dataSourceLookupMatch(map[string]string{dataSourceServerHostnameKey: "web-1.example.com", dataSourceServerLabelKey: "Web 2"}, attributes) {
+		t.Fatalf("Error in dataSourceLookupMatch: Criteria are not combined")
+	}
+}
+
+// TestDataSourceLookupResult tests the dataSourceLookupResult function.
+func TestDataSourceLookupResult(t *testing.T) {
+	criteria := map[string]string{dataSourceServerHostnameKey: "web-1.example.com"}
+
+	if dataSourceLookupResult("server", map[string]string{}, 1) == nil {
+		t.Fatalf("Error in dataSourceLookupResult: Missing criteria are accepted")
+	}
+
+	if dataSourceLookupResult("server", criteria, 0) == nil {
+		t.Fatalf("Error in dataSourceLookupResult: Zero matches are accepted")
+	}
+
+	if dataSourceLookupResult("server", criteria, 2) == nil {
+		t.Fatalf("Error in dataSourceLookupResult: Multiple matches are accepted")
+	}
+
+	if err := dataSourceLookupResult("server", criteria, 1); err != nil {
+		t.Fatalf("Error in dataSourceLookupResult: %s", err.Error())
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -20,14 +21,17 @@ const (
 	dataSourceNetworkInterfaceFirewallRulesAddressesKey = "firewall_rules_addresses"
 	dataSourceNetworkInterfaceFirewallRulesCommandsKey  = "firewall_rules_commands"
 	dataSourceNetworkInterfaceFirewallRulesIdsKey       = "firewall_rules_ids"
+	dataSourceNetworkInterfaceFirewallRulesKey          = "firewall_rules"
 	dataSourceNetworkInterfaceFirewallRulesPortsKey     = "firewall_rules_ports"
 	dataSourceNetworkInterfaceFirewallRulesProtocolsKey = "firewall_rules_protocols"
 	dataSourceNetworkInterfaceGatewaysKey               = "gateways"
 	dataSourceNetworkInterfaceIDKey                     = "id"
+	dataSourceNetworkInterfaceIPAddressesKey            = "ip_addresses"
 	dataSourceNetworkInterfaceLabelKey                  = "label"
 	dataSourceNetworkInterfaceNetmasksKey               = "netmasks"
 	dataSourceNetworkInterfaceNetworksKey               = "networks"
 	dataSourceNetworkInterfacePrimaryKey                = "primary"
+	dataSourceNetworkInterfacePrivateNetworkIDKey       = "private_network_id"
 	dataSourceNetworkInterfaceRateLimitKey              = "rate_limit"
 	dataSourceNetworkInterfaceServerIDKey               = "server_id"
 )
@@ -51,32 +55,43 @@ func dataSourceNetworkInterface() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The CIDR blocks for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'firewall_rules' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceNetworkInterfaceFirewallRulesCommandsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The commands for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'firewall_rules' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceNetworkInterfaceFirewallRulesIdsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The identifiers for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'firewall_rules' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceNetworkInterfaceFirewallRulesPortsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The ports of the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'firewall_rules' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceNetworkInterfaceFirewallRulesProtocolsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The protocols for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'firewall_rules' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			dataSourceNetworkInterfaceFirewallRulesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The firewall rules assigned to the network interface",
+				Elem:        dataSourceFirewallRuleElem(),
+			},
 			dataSourceNetworkInterfaceGatewaysKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -85,14 +100,23 @@ func dataSourceNetworkInterface() *schema.Resource {
 			},
 			dataSourceNetworkInterfaceIDKey: {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Description: "The network interface identifier",
 				ForceNew:    true,
 			},
+			dataSourceNetworkInterfaceIPAddressesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IP addresses assigned to the network interface",
+				Elem:        dataSourceIPAddressElem(),
+			},
 			dataSourceNetworkInterfaceLabelKey: {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The network interface label",
+				ForceNew:    true,
 			},
 			dataSourceNetworkInterfaceNetmasksKey: {
 				Type:        schema.TypeList,
@@ -108,8 +132,15 @@ func dataSourceNetworkInterface() *schema.Resource {
 			},
 			dataSourceNetworkInterfacePrimaryKey: {
 				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 				Description: "Whether the network interface is the primary interface",
+				ForceNew:    true,
+			},
+			dataSourceNetworkInterfacePrivateNetworkIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier for the private network that the network interface is attached to",
 			},
 			dataSourceNetworkInterfaceRateLimitKey: {
 				Type:        schema.TypeInt,
@@ -135,6 +166,10 @@ func dataSourceNetworkInterfaceRead(d *schema.ResourceData, m interface{}) error
 	networkInterfaceID := d.Get(dataSourceNetworkInterfaceIDKey).(string)
 	serverID := d.Get(dataSourceNetworkInterfaceServerIDKey).(string)
 
+	if len(networkInterfaceID) == 0 {
+		return dataSourceNetworkInterfaceLookup(d, m, serverID)
+	}
+
 	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/network-interfaces/%s", serverID, networkInterfaceID), new(bytes.Buffer))
 
 	if err != nil {
@@ -157,6 +192,74 @@ func dataSourceNetworkInterfaceRead(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
+	return dataSourceNetworkInterfaceReadResponseBody(d, m, &networkInterface)
+}
+
+// dataSourceNetworkInterfaceLookup reads information about the server's network interface matching the label and primary arguments.
+func dataSourceNetworkInterfaceLookup(d *schema.ResourceData, m interface{}, serverID string) error {
+	criteria := map[string]string{}
+
+	if v, ok := d.GetOk(dataSourceNetworkInterfaceLabelKey); ok {
+		criteria[dataSourceNetworkInterfaceLabelKey] = v.(string)
+	}
+
+	if v, ok := d.GetOk(dataSourceNetworkInterfacePrimaryKey); ok && v.(bool) {
+		criteria[dataSourceNetworkInterfacePrimaryKey] = strconv.FormatBool(true)
+	}
+
+	err := dataSourceLookupResult("network interface", criteria, 1)
+
+	if err != nil {
+		return err
+	}
+
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/network-interfaces", serverID), new(bytes.Buffer))
+
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return err
+	} else if res.StatusCode != 200 {
+		return fmt.Errorf("Failed to read the information about the network interfaces - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	list := make(clouddk.NetworkInterfaceListBody, 0)
+	err = json.NewDecoder(res.Body).Decode(&list)
+
+	if err != nil {
+		return err
+	}
+
+	matches := make(clouddk.NetworkInterfaceListBody, 0, 1)
+
+	for _, v := range list {
+		attributes := map[string]string{
+			dataSourceNetworkInterfaceLabelKey:   v.Label,
+			dataSourceNetworkInterfacePrimaryKey: strconv.FormatBool(bool(v.Primary)),
+		}
+
+		if dataSourceLookupMatch(criteria, attributes) {
+			matches = append(matches, v)
+		}
+	}
+
+	err = dataSourceLookupResult("network interface", criteria, len(matches))
+
+	if err != nil {
+		return err
+	}
+
+	return dataSourceNetworkInterfaceReadResponseBody(d, m, &matches[0])
+}
+
+// dataSourceNetworkInterfaceReadResponseBody parses information about a server's network interface.
+func dataSourceNetworkInterfaceReadResponseBody(d *schema.ResourceData, m interface{}, networkInterface *clouddk.NetworkInterfaceBody) error {
 	addresses := make([]interface{}, len(networkInterface.IPAddresses))
 	gateways := make([]interface{}, len(networkInterface.IPAddresses))
 	netmasks := make([]interface{}, len(networkInterface.IPAddresses))
@@ -183,7 +286,7 @@ func dataSourceNetworkInterfaceRead(d *schema.ResourceData, m interface{}) error
 		firewallRulesProtocols[v.Position-1] = v.Protocol
 	}
 
-	d.SetId(networkInterfaceID)
+	d.SetId(networkInterface.Identifier)
 
 	d.Set(dataSourceNetworkInterfaceAddressesKey, addresses)
 
@@ -192,14 +295,92 @@ func dataSourceNetworkInterfaceRead(d *schema.ResourceData, m interface{}) error
 	d.Set(dataSourceNetworkInterfaceFirewallRulesIdsKey, firewallRulesIds)
 	d.Set(dataSourceNetworkInterfaceFirewallRulesPortsKey, firewallRulesPorts)
 	d.Set(dataSourceNetworkInterfaceFirewallRulesProtocolsKey, firewallRulesProtocols)
+	d.Set(dataSourceNetworkInterfaceFirewallRulesKey, dataSourceFirewallRuleFlattenList(networkInterface.FirewallRules))
 
 	d.Set(dataSourceNetworkInterfaceGatewaysKey, gateways)
+	d.Set(dataSourceNetworkInterfaceIPAddressesKey, dataSourceIPAddressFlattenList(networkInterface.IPAddresses))
 	d.Set(dataSourceNetworkInterfaceDefaultFirewallRuleKey, networkInterface.DefaultFirewallRule)
 	d.Set(dataSourceNetworkInterfaceLabelKey, networkInterface.Label)
 	d.Set(dataSourceNetworkInterfaceNetmasksKey, netmasks)
 	d.Set(dataSourceNetworkInterfaceNetworksKey, networks)
 	d.Set(dataSourceNetworkInterfacePrimaryKey, networkInterface.Primary)
+	d.Set(dataSourceNetworkInterfacePrivateNetworkIDKey, networkInterface.PrivateNetwork)
 	d.Set(dataSourceNetworkInterfaceRateLimitKey, networkInterface.RateLimit)
 
 	return nil
 }
+
+// dataSourceNetworkInterfaceElem returns the schema for a network interface object.
+func dataSourceNetworkInterfaceElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceNetworkInterfaceDefaultFirewallRuleKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The default firewall rule for the network interface",
+			},
+			dataSourceNetworkInterfaceFirewallRulesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The firewall rules assigned to the network interface",
+				Elem:        dataSourceFirewallRuleElem(),
+			},
+			dataSourceNetworkInterfaceIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network interface identifier",
+			},
+			dataSourceNetworkInterfaceIPAddressesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IP addresses assigned to the network interface",
+				Elem:        dataSourceIPAddressElem(),
+			},
+			dataSourceNetworkInterfaceLabelKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network interface label",
+			},
+			dataSourceNetworkInterfacePrimaryKey: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the network interface is the primary interface",
+			},
+			dataSourceNetworkInterfacePrivateNetworkIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier for the private network that the network interface is attached to",
+			},
+			dataSourceNetworkInterfaceRateLimitKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The rate limit for the network interface",
+			},
+		},
+	}
+}
+
+// dataSourceNetworkInterfaceFlatten converts a network interface to an object.
+func dataSourceNetworkInterfaceFlatten(networkInterface *clouddk.NetworkInterfaceBody) map[string]interface{} {
+	return map[string]interface{}{
+		dataSourceNetworkInterfaceDefaultFirewallRuleKey: networkInterface.DefaultFirewallRule,
+		dataSourceNetworkInterfaceFirewallRulesKey:       dataSourceFirewallRuleFlattenList(networkInterface.FirewallRules),
+		dataSourceNetworkInterfaceIDKey:                  networkInterface.Identifier,
+		dataSourceNetworkInterfaceIPAddressesKey:         dataSourceIPAddressFlattenList(networkInterface.IPAddresses),
+		dataSourceNetworkInterfaceLabelKey:               networkInterface.Label,
+		dataSourceNetworkInterfacePrimaryKey:             bool(networkInterface.Primary),
+		dataSourceNetworkInterfacePrivateNetworkIDKey:    networkInterface.PrivateNetwork,
+		dataSourceNetworkInterfaceRateLimitKey:           int(networkInterface.RateLimit),
+	}
+}
+
+// dataSourceNetworkInterfaceFlattenList converts a list of network interfaces to a list of objects.
+func dataSourceNetworkInterfaceFlattenList(networkInterfaces clouddk.NetworkInterfaceListBody) []interface{} {
+	list := make([]interface{}, len(networkInterfaces))
+
+	for i := range networkInterfaces {
+		list[i] = dataSourceNetworkInterfaceFlatten(&networkInterfaces[i])
+	}
+
+	return list
+}
@@ -22,7 +22,6 @@ func TestDataSourceNetworkInterfaceSchema(t *testing.T) {
 	s := dataSourceNetworkInterface()
 
 	idKeys := []string{
-		dataSourceNetworkInterfaceIDKey,
 		dataSourceNetworkInterfaceServerIDKey,
 	}
 
@@ -36,19 +35,38 @@ func TestDataSourceNetworkInterfaceSchema(t *testing.T) {
 		}
 	}
 
+	lookupKeys := []string{
+		dataSourceNetworkInterfaceIDKey,
+		dataSourceNetworkInterfaceLabelKey,
+		dataSourceNetworkInterfacePrimaryKey,
+	}
+
+	for _, v := range lookupKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourceNetworkInterface.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Optional != true || s.Schema[v].Computed != true {
+			t.Fatalf("Error in dataSourceNetworkInterface.Schema: Argument \"%s\" is not optional and computed", v)
+		}
+	}
+
 	attributeKeys := []string{
 		dataSourceNetworkInterfaceAddressesKey,
 		dataSourceNetworkInterfaceDefaultFirewallRuleKey,
 		dataSourceNetworkInterfaceFirewallRulesAddressesKey,
 		dataSourceNetworkInterfaceFirewallRulesCommandsKey,
 		dataSourceNetworkInterfaceFirewallRulesIdsKey,
+		dataSourceNetworkInterfaceFirewallRulesKey,
 		dataSourceNetworkInterfaceFirewallRulesPortsKey,
 		dataSourceNetworkInterfaceFirewallRulesProtocolsKey,
 		dataSourceNetworkInterfaceGatewaysKey,
+		dataSourceNetworkInterfaceIPAddressesKey,
 		dataSourceNetworkInterfaceLabelKey,
 		dataSourceNetworkInterfaceNetmasksKey,
 		dataSourceNetworkInterfaceNetworksKey,
 		dataSourceNetworkInterfacePrimaryKey,
+		dataSourceNetworkInterfacePrivateNetworkIDKey,
 		dataSourceNetworkInterfaceRateLimitKey,
 	}
 
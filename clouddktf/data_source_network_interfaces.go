@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -17,6 +18,7 @@ import (
 const (
 	dataSourceNetworkInterfacesAddressesKey              = "addresses"
 	dataSourceNetworkInterfacesDefaultFirewallRulesKey   = "default_firewall_rules"
+	dataSourceNetworkInterfacesFilterKey                 = "filter"
 	dataSourceNetworkInterfacesFirewallRulesAddressesKey = "firewall_rules_addresses"
 	dataSourceNetworkInterfacesFirewallRulesCommandsKey  = "firewall_rules_commands"
 	dataSourceNetworkInterfacesFirewallRulesIdsKey       = "firewall_rules_ids"
@@ -28,10 +30,21 @@ const (
 	dataSourceNetworkInterfacesLabelsKey                 = "labels"
 	dataSourceNetworkInterfacesNetmasksKey               = "netmasks"
 	dataSourceNetworkInterfacesNetworksKey               = "networks"
+	dataSourceNetworkInterfacesNetworkInterfacesKey      = "network_interfaces"
 	dataSourceNetworkInterfacesPrimaryKey                = "primary"
 	dataSourceNetworkInterfacesRateLimitsKey             = "rate_limits"
 )
 
+var (
+	dataSourceNetworkInterfacesFilterNames = []string{
+		dataSourceNetworkInterfaceDefaultFirewallRuleKey,
+		dataSourceNetworkInterfaceIDKey,
+		dataSourceNetworkInterfaceLabelKey,
+		dataSourceNetworkInterfacePrimaryKey,
+		dataSourceNetworkInterfaceRateLimitKey,
+	}
+)
+
 // dataSourceNetworkInterfaces retrieves information about a server.
 func dataSourceNetworkInterfaces() *schema.Resource {
 	return &schema.Resource{
@@ -40,6 +53,7 @@ func dataSourceNetworkInterfaces() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The IP addresses assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -49,12 +63,15 @@ func dataSourceNetworkInterfaces() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The default firewall rules for the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			dataSourceNetworkInterfacesFilterKey: dataSourceFilterSchema(nil),
 			dataSourceNetworkInterfacesFirewallRulesAddressesKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The CIDR blocks for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -64,6 +81,7 @@ func dataSourceNetworkInterfaces() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The commands for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -73,6 +91,7 @@ func dataSourceNetworkInterfaces() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The identifiers for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -82,6 +101,7 @@ func dataSourceNetworkInterfaces() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The ports of the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -91,6 +111,7 @@ func dataSourceNetworkInterfaces() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The protocols for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -100,6 +121,7 @@ func dataSourceNetworkInterfaces() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The gateways assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -115,18 +137,21 @@ func dataSourceNetworkInterfaces() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's network interface identifiers",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceNetworkInterfacesLabelsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's network interface labels",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceNetworkInterfacesNetmasksKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The netmasks assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -136,21 +161,30 @@ func dataSourceNetworkInterfaces() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The networks assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
 				},
 			},
+			dataSourceNetworkInterfacesNetworkInterfacesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server's network interfaces",
+				Elem:        dataSourceNetworkInterfaceElem(),
+			},
 			dataSourceNetworkInterfacesPrimaryKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "Whether a network interface is the primary interface",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeBool},
 			},
 			dataSourceNetworkInterfacesRateLimitsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The rate limits for the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
 		},
@@ -161,6 +195,12 @@ func dataSourceNetworkInterfaces() *schema.Resource {
 
 // dataSourceNetworkInterfacesRead reads information about a server.
 func dataSourceNetworkInterfacesRead(d *schema.ResourceData, m interface{}) error {
+	filters, err := dataSourceFilterRead(d, dataSourceNetworkInterfacesFilterNames, nil)
+
+	if err != nil {
+		return err
+	}
+
 	clientSettings := m.(clouddk.ClientSettings)
 
 	id := d.Get(dataSourceNetworkInterfacesIDKey).(string)
@@ -179,13 +219,29 @@ func dataSourceNetworkInterfacesRead(d *schema.ResourceData, m interface{}) erro
 		return fmt.Errorf("Failed to read the information about the network interfaces - Reason: The API responded with HTTP %s", res.Status)
 	}
 
-	networkInterfaces := clouddk.NetworkInterfaceListBody{}
-	err = json.NewDecoder(res.Body).Decode(&networkInterfaces)
+	unfilteredNetworkInterfaces := clouddk.NetworkInterfaceListBody{}
+	err = json.NewDecoder(res.Body).Decode(&unfilteredNetworkInterfaces)
 
 	if err != nil {
 		return err
 	}
 
+	networkInterfaces := make(clouddk.NetworkInterfaceListBody, 0, len(unfilteredNetworkInterfaces))
+
+	for _, v := range unfilteredNetworkInterfaces {
+		attributes := map[string]string{
+			dataSourceNetworkInterfaceDefaultFirewallRuleKey: v.DefaultFirewallRule,
+			dataSourceNetworkInterfaceIDKey:                  v.Identifier,
+			dataSourceNetworkInterfaceLabelKey:               v.Label,
+			dataSourceNetworkInterfacePrimaryKey:             strconv.FormatBool(bool(v.Primary)),
+			dataSourceNetworkInterfaceRateLimitKey:           strconv.Itoa(int(v.RateLimit)),
+		}
+
+		if dataSourceFilterMatch(filters, attributes) {
+			networkInterfaces = append(networkInterfaces, v)
+		}
+	}
+
 	networkInterfaceAddresses := make([]interface{}, len(networkInterfaces))
 	networkInterfaceDefaultFirewallRules := make([]interface{}, len(networkInterfaces))
 	networkInterfaceFirewallRuleAddresses := make([]interface{}, len(networkInterfaces))
@@ -262,6 +318,7 @@ func dataSourceNetworkInterfacesRead(d *schema.ResourceData, m interface{}) erro
 	d.Set(dataSourceNetworkInterfacesLabelsKey, networkInterfaceLabels)
 	d.Set(dataSourceNetworkInterfacesNetmasksKey, networkInterfaceNetmasks)
 	d.Set(dataSourceNetworkInterfacesNetworksKey, networkInterfaceNetworks)
+	d.Set(dataSourceNetworkInterfacesNetworkInterfacesKey, dataSourceNetworkInterfaceFlattenList(networkInterfaces))
 	d.Set(dataSourceNetworkInterfacesPrimaryKey, networkInterfacePrimary)
 	d.Set(dataSourceNetworkInterfacesRateLimitsKey, networkInterfaceRateLimits)
 
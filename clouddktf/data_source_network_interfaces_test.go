@@ -41,6 +41,7 @@ func TestDataSourceNetworkInterfacesSchema(t *testing.T) {
 		dataSourceNetworkInterfacesIdsKey,
 		dataSourceNetworkInterfacesLabelsKey,
 		dataSourceNetworkInterfacesNetmasksKey,
+		dataSourceNetworkInterfacesNetworkInterfacesKey,
 		dataSourceNetworkInterfacesNetworksKey,
 		dataSourceNetworkInterfacesPrimaryKey,
 		dataSourceNetworkInterfacesRateLimitsKey,
@@ -0,0 +1,202 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	dataSourcePackageBandwidthKey    = "bandwidth"
+	dataSourcePackageCPUsKey         = "cpus"
+	dataSourcePackageDiskSizeKey     = "disk_size"
+	dataSourcePackageIDKey           = "id"
+	dataSourcePackageMemoryKey       = "memory"
+	dataSourcePackageMinCPUsKey      = "min_cpus"
+	dataSourcePackageMinDiskKey      = "min_disk"
+	dataSourcePackageMinMemoryKey    = "min_memory"
+	dataSourcePackageMonthlyPriceKey = "monthly_price"
+	dataSourcePackageNameKey         = "name"
+)
+
+// dataSourcePackage retrieves information about the smallest server package which meets a set of requirements.
+func dataSourcePackage() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourcePackageBandwidthKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The monthly bandwidth in gigabytes",
+			},
+			dataSourcePackageCPUsKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The CPU count",
+			},
+			dataSourcePackageDiskSizeKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The disk size in gigabytes",
+			},
+			dataSourcePackageIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The package identifier",
+			},
+			dataSourcePackageMemoryKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The memory allocation in megabytes",
+			},
+			dataSourcePackageMinCPUsKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The minimum CPU count",
+			},
+			dataSourcePackageMinDiskKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The minimum disk size in gigabytes",
+			},
+			dataSourcePackageMinMemoryKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The minimum memory allocation in megabytes",
+			},
+			dataSourcePackageMonthlyPriceKey: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The monthly price",
+			},
+			dataSourcePackageNameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The package name",
+			},
+		},
+
+		Read: dataSourcePackageRead,
+	}
+}
+
+// dataSourcePackageRead reads information about the smallest server package which meets a set of requirements.
+func dataSourcePackageRead(d *schema.ResourceData, m interface{}) error {
+	minCPUs := d.Get(dataSourcePackageMinCPUsKey).(int)
+	minDisk := d.Get(dataSourcePackageMinDiskKey).(int)
+	minMemory := d.Get(dataSourcePackageMinMemoryKey).(int)
+
+	list, err := dataSourcePackagesList(m)
+
+	if err != nil {
+		return err
+	}
+
+	candidates := make(clouddk.PackageeListBody, 0, len(list))
+
+	for _, v := range list {
+		if int(v.CPUs) >= minCPUs && int(v.DiskSize) >= minDisk && int(v.Memory) >= minMemory {
+			candidates = append(candidates, v)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return fmt.Errorf("No package meets the requirements (%s = %d, %s = %d, %s = %d)", dataSourcePackageMinCPUsKey, minCPUs, dataSourcePackageMinDiskKey, minDisk, dataSourcePackageMinMemoryKey, minMemory)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return dataSourcePackageLess(&candidates[i], &candidates[j])
+	})
+
+	p := candidates[0]
+
+	d.SetId(p.Identifier)
+
+	d.Set(dataSourcePackageBandwidthKey, int(p.Bandwidth))
+	d.Set(dataSourcePackageCPUsKey, int(p.CPUs))
+	d.Set(dataSourcePackageDiskSizeKey, int(p.DiskSize))
+	d.Set(dataSourcePackageIDKey, p.Identifier)
+	d.Set(dataSourcePackageMemoryKey, int(p.Memory))
+	d.Set(dataSourcePackageMonthlyPriceKey, float64(p.MonthlyPrice))
+	d.Set(dataSourcePackageNameKey, p.Name)
+
+	return nil
+}
+
+// dataSourcePackageLess determines whether a package is smaller than another package.
+// Packages are primarily ordered by price and secondarily by their specifications.
+func dataSourcePackageLess(a *clouddk.PackageBody, b *clouddk.PackageBody) bool {
+	if a.MonthlyPrice != b.MonthlyPrice {
+		return a.MonthlyPrice < b.MonthlyPrice
+	} else if a.CPUs != b.CPUs {
+		return a.CPUs < b.CPUs
+	} else if a.Memory != b.Memory {
+		return a.Memory < b.Memory
+	}
+
+	return a.DiskSize < b.DiskSize
+}
+
+// dataSourcePackageElem returns the schema for a package object.
+func dataSourcePackageElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourcePackageBandwidthKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The monthly bandwidth in gigabytes",
+			},
+			dataSourcePackageCPUsKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The CPU count",
+			},
+			dataSourcePackageDiskSizeKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The disk size in gigabytes",
+			},
+			dataSourcePackageIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The package identifier",
+			},
+			dataSourcePackageMemoryKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The memory allocation in megabytes",
+			},
+			dataSourcePackageMonthlyPriceKey: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The monthly price",
+			},
+			dataSourcePackageNameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The package name",
+			},
+		},
+	}
+}
+
+// dataSourcePackageFlatten converts a package to an object.
+func dataSourcePackageFlatten(p *clouddk.PackageBody) map[string]interface{} {
+	return map[string]interface{}{
+		dataSourcePackageBandwidthKey:    int(p.Bandwidth),
+		dataSourcePackageCPUsKey:         int(p.CPUs),
+		dataSourcePackageDiskSizeKey:     int(p.DiskSize),
+		dataSourcePackageIDKey:           p.Identifier,
+		dataSourcePackageMemoryKey:       int(p.Memory),
+		dataSourcePackageMonthlyPriceKey: float64(p.MonthlyPrice),
+		dataSourcePackageNameKey:         p.Name,
+	}
+}
@@ -0,0 +1,81 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+)
+
+// TestDataSourcePackageInstantiation tests whether the dataSourcePackage instance can be instantiated.
+func TestDataSourcePackageInstantiation(t *testing.T) {
+	s := dataSourcePackage()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourcePackage")
+	}
+}
+
+// TestDataSourcePackageSchema tests the dataSourcePackage schema.
+func TestDataSourcePackageSchema(t *testing.T) {
+	s := dataSourcePackage()
+
+	argumentKeys := []string{
+		dataSourcePackageMinCPUsKey,
+		dataSourcePackageMinDiskKey,
+		dataSourcePackageMinMemoryKey,
+	}
+
+	for _, v := range argumentKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourcePackage.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Optional != true {
+			t.Fatalf("Error in dataSourcePackage.Schema: Argument \"%s\" is not optional", v)
+		}
+	}
+
+	attributeKeys := []string{
+		dataSourcePackageBandwidthKey,
+		dataSourcePackageCPUsKey,
+		dataSourcePackageDiskSizeKey,
+		dataSourcePackageIDKey,
+		dataSourcePackageMemoryKey,
+		dataSourcePackageMonthlyPriceKey,
+		dataSourcePackageNameKey,
+	}
+
+	for _, v := range attributeKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourcePackage.Schema: Missing attribute \"%s\"", v)
+		}
+
+		if s.Schema[v].Computed != true {
+			t.Fatalf("Error in dataSourcePackage.Schema: Attribute \"%s\" is not computed", v)
+		}
+	}
+}
+
+// TestDataSourcePackageLess tests the dataSourcePackageLess function.
+func TestDataSourcePackageLess(t *testing.T) {
+	small := clouddk.PackageBody{CPUs: 1, Memory: 1024, DiskSize: 20, MonthlyPrice: 50}
+	large := clouddk.PackageBody{CPUs: 2, Memory: 2048, DiskSize: 40, MonthlyPrice: 100}
+
+	if !dataSourcePackageLess(&small, &large) {
+		t.Fatalf("Error in dataSourcePackageLess: The cheaper package is not smaller")
+	}
+
+	if dataSourcePackageLess(&large, &small) {
+		t.Fatalf("Error in dataSourcePackageLess: The more expensive package is smaller")
+	}
+
+	large.MonthlyPrice = small.MonthlyPrice
+
+	if !dataSourcePackageLess(&small, &large) {
+		t.Fatalf("Error in dataSourcePackageLess: The package with fewer CPUs is not smaller when the prices are equal")
+	}
+}
@@ -9,20 +9,35 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 const (
-	dataSourcePackagesIdsKey   = "ids"
-	dataSourcePackagesNamesKey = "names"
+	dataSourcePackagesFilterKey   = "filter"
+	dataSourcePackagesIdsKey      = "ids"
+	dataSourcePackagesNamesKey    = "names"
+	dataSourcePackagesPackagesKey = "packages"
+)
+
+var (
+	dataSourcePackagesFilterNames = []string{
+		dataSourcePackageBandwidthKey,
+		dataSourcePackageCPUsKey,
+		dataSourcePackageDiskSizeKey,
+		dataSourcePackageIDKey,
+		dataSourcePackageMemoryKey,
+		dataSourcePackageNameKey,
+	}
 )
 
 // dataSourcePackages retrieves a list of server packages.
 func dataSourcePackages() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			dataSourcePackagesFilterKey: dataSourceFilterSchema(nil),
 			dataSourcePackagesIdsKey: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -33,6 +48,12 @@ func dataSourcePackages() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			dataSourcePackagesPackagesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The packages",
+				Elem:        dataSourcePackageElem(),
+			},
 		},
 
 		Read: dataSourcePackagesRead,
@@ -41,41 +62,78 @@ func dataSourcePackages() *schema.Resource {
 
 // dataSourcePackagesRead reads information about server packages.
 func dataSourcePackagesRead(d *schema.ResourceData, m interface{}) error {
-	clientSettings := m.(clouddk.ClientSettings)
-	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", "cloudservers/get-packages", new(bytes.Buffer))
+	filters, err := dataSourceFilterRead(d, dataSourcePackagesFilterNames, nil)
 
 	if err != nil {
 		return err
 	}
 
-	client := &http.Client{}
-	res, err := client.Do(req)
+	unfilteredList, err := dataSourcePackagesList(m)
 
 	if err != nil {
 		return err
-	} else if res.StatusCode != 200 {
-		return fmt.Errorf("Failed to read the information about the packages - Reason: The API responded with HTTP %s", res.Status)
 	}
 
-	list := make(clouddk.PackageeListBody, 0)
-	err = json.NewDecoder(res.Body).Decode(&list)
-
-	if err != nil {
-		return err
+	list := make(clouddk.PackageeListBody, 0, len(unfilteredList))
+
+	for _, v := range unfilteredList {
+		attributes := map[string]string{
+			dataSourcePackageBandwidthKey: strconv.Itoa(int(v.Bandwidth)),
+			dataSourcePackageCPUsKey:      strconv.Itoa(int(v.CPUs)),
+			dataSourcePackageDiskSizeKey:  strconv.Itoa(int(v.DiskSize)),
+			dataSourcePackageIDKey:        v.Identifier,
+			dataSourcePackageMemoryKey:    strconv.Itoa(int(v.Memory)),
+			dataSourcePackageNameKey:      v.Name,
+		}
+
+		if dataSourceFilterMatch(filters, attributes) {
+			list = append(list, v)
+		}
 	}
 
 	ids := make([]interface{}, len(list))
 	names := make([]interface{}, len(list))
+	packages := make([]interface{}, len(list))
 
 	for i, v := range list {
 		ids[i] = v.Identifier
 		names[i] = v.Name
+		packages[i] = dataSourcePackageFlatten(&list[i])
 	}
 
 	d.SetId("locations")
 
 	d.Set(dataSourcePackagesIdsKey, ids)
 	d.Set(dataSourcePackagesNamesKey, names)
+	d.Set(dataSourcePackagesPackagesKey, packages)
 
 	return nil
 }
+
+// dataSourcePackagesList retrieves the list of server packages.
+func dataSourcePackagesList(m interface{}) (clouddk.PackageeListBody, error) {
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", "cloudservers/get-packages", new(bytes.Buffer))
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode != 200 {
+		return nil, fmt.Errorf("Failed to read the information about the packages - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	list := make(clouddk.PackageeListBody, 0)
+	err = json.NewDecoder(res.Body).Decode(&list)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -24,6 +24,7 @@ func TestDataSourcePackagesSchema(t *testing.T) {
 	attributeKeys := []string{
 		dataSourcePackagesIdsKey,
 		dataSourcePackagesNamesKey,
+		dataSourcePackagesPackagesKey,
 	}
 
 	for _, v := range attributeKeys {
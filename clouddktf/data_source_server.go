@@ -8,7 +8,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -21,6 +23,7 @@ const (
 	dataSourceServerDiskLabelsKey                             = "disk_labels"
 	dataSourceServerDiskPrimaryKey                            = "disk_primary"
 	dataSourceServerDiskSizesKey                              = "disk_sizes"
+	dataSourceServerDisksKey                                  = "disks"
 	dataSourceServerHostnameKey                               = "hostname"
 	dataSourceServerIDKey                                     = "id"
 	dataSourceServerLabelKey                                  = "label"
@@ -39,10 +42,12 @@ const (
 	dataSourceServerNetworkInterfaceNetworksKey               = "network_interface_networks"
 	dataSourceServerNetworkInterfacePrimaryKey                = "network_interface_primary"
 	dataSourceServerNetworkInterfaceRateLimitsKey             = "network_interface_rate_limits"
+	dataSourceServerNetworkInterfacesKey                      = "network_interfaces"
 	dataSourceServerLocationIDKey                             = "location_id"
 	dataSourceServerLocationNameKey                           = "location_name"
 	dataSourceServerPackageIDKey                              = "package_id"
 	dataSourceServerPackageNameKey                            = "package_name"
+	dataSourceServerPrimaryIPv4AddressKey                     = "primary_ipv4_address"
 	dataSourceServerTemplateIDKey                             = "template_id"
 	dataSourceServerTemplateNameKey                           = "template_name"
 )
@@ -65,41 +70,56 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's disk identifiers",
+				Deprecated:  "Use the 'disks' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceServerDiskLabelsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's disk labels",
+				Deprecated:  "Use the 'disks' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceServerDiskPrimaryKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "Whether a disk is the primary disk",
+				Deprecated:  "Use the 'disks' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeBool},
 			},
 			dataSourceServerDiskSizesKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's disk sizes in gigabytes",
+				Deprecated:  "Use the 'disks' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			dataSourceServerDisksKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server's disks",
+				Elem:        dataSourceDiskElem(),
+			},
 			dataSourceServerHostnameKey: {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The server hostname",
+				ForceNew:    true,
 			},
 			dataSourceServerIDKey: {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				Description: "The server identifier",
 				ForceNew:    true,
 			},
 			dataSourceServerLabelKey: {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The server label",
+				ForceNew:    true,
 			},
 			dataSourceServerLocationIDKey: {
 				Type:        schema.TypeString,
@@ -120,6 +140,7 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The IP addresses assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -129,12 +150,14 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The default firewall rules for the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceServerNetworkInterfaceFirewallRulesAddressesKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The CIDR blocks for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -144,6 +167,7 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The commands for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -153,6 +177,7 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The identifiers for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -162,6 +187,7 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The ports of the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -171,6 +197,7 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The protocols for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -180,6 +207,7 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The gateways assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -189,18 +217,21 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's network interface identifiers",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceServerNetworkInterfaceLabelsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's network interface labels",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceServerNetworkInterfaceNetmasksKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The netmasks assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -210,6 +241,7 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The networks assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -219,14 +251,22 @@ func dataSourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "Whether a network interface is the primary interface",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeBool},
 			},
 			dataSourceServerNetworkInterfaceRateLimitsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The rate limits for the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			dataSourceServerNetworkInterfacesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server's network interfaces",
+				Elem:        dataSourceNetworkInterfaceElem(),
+			},
 			dataSourceServerPackageIDKey: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -237,6 +277,11 @@ func dataSourceServer() *schema.Resource {
 				Computed:    true,
 				Description: "The package name",
 			},
+			dataSourceServerPrimaryIPv4AddressKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first public IPv4 address assigned to the primary network interface",
+			},
 			dataSourceServerTemplateIDKey: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -262,6 +307,15 @@ func dataSourceServerRead(d *schema.ResourceData, m interface{}) error {
 		id = d.Get(dataSourceServerIDKey).(string)
 	}
 
+	if len(id) == 0 {
+		var err error
+		id, err = dataSourceServerLookup(d, m)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s", id), new(bytes.Buffer))
 
 	if err != nil {
@@ -287,6 +341,74 @@ func dataSourceServerRead(d *schema.ResourceData, m interface{}) error {
 	return dataSourceServerReadResponseBody(d, m, &server)
 }
 
+// dataSourceServerLookup looks up the identifier of the server matching the hostname and label arguments.
+func dataSourceServerLookup(d *schema.ResourceData, m interface{}) (string, error) {
+	criteria := map[string]string{}
+
+	for _, k := range []string{dataSourceServerHostnameKey, dataSourceServerLabelKey} {
+		if v, ok := d.GetOk(k); ok {
+			criteria[k] = v.(string)
+		}
+	}
+
+	err := dataSourceLookupResult("server", criteria, 1)
+
+	if err != nil {
+		return "", err
+	}
+
+	// The API performs a substring match on the hostname, which is why we still need to compare the hostnames.
+	path := "cloudservers?per-page=1000"
+
+	if hostname, ok := criteria[dataSourceServerHostnameKey]; ok {
+		path = fmt.Sprintf("%s&hostname=%s", path, url.QueryEscape(hostname))
+	}
+
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", path, new(bytes.Buffer))
+
+	if err != nil {
+		return "", err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return "", err
+	} else if res.StatusCode != 200 {
+		return "", fmt.Errorf("Failed to read the information about the servers - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	list := make(clouddk.ServerListBody, 0)
+	err = json.NewDecoder(res.Body).Decode(&list)
+
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, 0, 1)
+
+	for _, v := range list {
+		attributes := map[string]string{
+			dataSourceServerHostnameKey: v.Hostname,
+			dataSourceServerLabelKey:    v.Label,
+		}
+
+		if dataSourceLookupMatch(criteria, attributes) {
+			ids = append(ids, v.Identifier)
+		}
+	}
+
+	err = dataSourceLookupResult("server", criteria, len(ids))
+
+	if err != nil {
+		return "", err
+	}
+
+	return ids[0], nil
+}
+
 // dataSourceServerReadResponseBody() reads the response body for a server request.
 func dataSourceServerReadResponseBody(d *schema.ResourceData, m interface{}, server *clouddk.ServerBody) error {
 	diskIds := make([]interface{}, len(server.Disks))
@@ -369,6 +491,7 @@ func dataSourceServerReadResponseBody(d *schema.ResourceData, m interface{}, ser
 	d.Set(dataSourceServerDiskLabelsKey, diskLabels)
 	d.Set(dataSourceServerDiskPrimaryKey, diskPrimary)
 	d.Set(dataSourceServerDiskSizesKey, diskSizes)
+	d.Set(dataSourceServerDisksKey, dataSourceDiskFlattenList(server.Disks))
 	d.Set(dataSourceServerHostnameKey, server.Hostname)
 	d.Set(dataSourceServerLabelKey, server.Label)
 	d.Set(dataSourceServerLocationIDKey, server.Location.Identifier)
@@ -390,11 +513,138 @@ func dataSourceServerReadResponseBody(d *schema.ResourceData, m interface{}, ser
 	d.Set(dataSourceServerNetworkInterfaceNetworksKey, networkInterfaceNetworks)
 	d.Set(dataSourceServerNetworkInterfacePrimaryKey, networkInterfacePrimary)
 	d.Set(dataSourceServerNetworkInterfaceRateLimitsKey, networkInterfaceRateLimits)
+	d.Set(dataSourceServerNetworkInterfacesKey, dataSourceNetworkInterfaceFlattenList(server.NetworkInterfaces))
 
 	d.Set(dataSourceServerPackageIDKey, server.Package.Identifier)
 	d.Set(dataSourceServerPackageNameKey, server.Package.Name)
+	d.Set(dataSourceServerPrimaryIPv4AddressKey, dataSourceServerPrimaryIPv4Address(server))
 	d.Set(dataSourceServerTemplateIDKey, server.Template.Identifier)
 	d.Set(dataSourceServerTemplateNameKey, server.Template.Name)
 
 	return nil
 }
+
+// dataSourceServerElem returns the schema for a server object.
+func dataSourceServerElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceServerBootedKey: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the server has been booted",
+			},
+			dataSourceServerCPUsKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The server's CPU count",
+			},
+			dataSourceServerDisksKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server's disks",
+				Elem:        dataSourceDiskElem(),
+			},
+			dataSourceServerHostnameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The server hostname",
+			},
+			dataSourceServerIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The server identifier",
+			},
+			dataSourceServerLabelKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The server label",
+			},
+			dataSourceServerLocationIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The location identifier",
+			},
+			dataSourceServerLocationNameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The location name",
+			},
+			dataSourceServerMemoryKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The server's memory allocation in megabytes",
+			},
+			dataSourceServerNetworkInterfacesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server's network interfaces",
+				Elem:        dataSourceNetworkInterfaceElem(),
+			},
+			dataSourceServerPackageIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The package identifier",
+			},
+			dataSourceServerPackageNameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The package name",
+			},
+			dataSourceServerPrimaryIPv4AddressKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first public IPv4 address assigned to the primary network interface",
+			},
+			dataSourceServerTemplateIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The template identifier",
+			},
+			dataSourceServerTemplateNameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The template name",
+			},
+		},
+	}
+}
+
+// dataSourceServerFlatten converts a server to an object.
+func dataSourceServerFlatten(server *clouddk.ServerBody) map[string]interface{} {
+	return map[string]interface{}{
+		dataSourceServerBootedKey:             bool(server.Booted),
+		dataSourceServerCPUsKey:               int(server.CPUs),
+		dataSourceServerDisksKey:              dataSourceDiskFlattenList(server.Disks),
+		dataSourceServerHostnameKey:           server.Hostname,
+		dataSourceServerIDKey:                 server.Identifier,
+		dataSourceServerLabelKey:              server.Label,
+		dataSourceServerLocationIDKey:         server.Location.Identifier,
+		dataSourceServerLocationNameKey:       server.Location.Name,
+		dataSourceServerMemoryKey:             int(server.Memory),
+		dataSourceServerNetworkInterfacesKey:  dataSourceNetworkInterfaceFlattenList(server.NetworkInterfaces),
+		dataSourceServerPackageIDKey:          server.Package.Identifier,
+		dataSourceServerPackageNameKey:        server.Package.Name,
+		dataSourceServerPrimaryIPv4AddressKey: dataSourceServerPrimaryIPv4Address(server),
+		dataSourceServerTemplateIDKey:         server.Template.Identifier,
+		dataSourceServerTemplateNameKey:       server.Template.Name,
+	}
+}
+
+// dataSourceServerPrimaryIPv4Address returns the first IPv4 address assigned to the primary network interface of a server.
+func dataSourceServerPrimaryIPv4Address(server *clouddk.ServerBody) string {
+	for _, v := range server.NetworkInterfaces {
+		if !bool(v.Primary) {
+			continue
+		}
+
+		for _, va := range v.IPAddresses {
+			ip := net.ParseIP(va.Address)
+
+			if ip != nil && ip.To4() != nil {
+				return va.Address
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,171 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	dataSourceServerBackupsBackupCreatedAtKey = "created_at"
+	dataSourceServerBackupsBackupIDKey        = "id"
+	dataSourceServerBackupsBackupLabelKey     = "label"
+	dataSourceServerBackupsBackupSizeKey      = "size"
+	dataSourceServerBackupsBackupTypeKey      = "type"
+	dataSourceServerBackupsBackupsKey         = "backups"
+	dataSourceServerBackupsFilterKey          = "filter"
+	dataSourceServerBackupsIdsKey             = "ids"
+	dataSourceServerBackupsServerIDKey        = "server_id"
+)
+
+var (
+	dataSourceServerBackupsFilterNames = []string{
+		dataSourceServerBackupsBackupCreatedAtKey,
+		dataSourceServerBackupsBackupIDKey,
+		dataSourceServerBackupsBackupLabelKey,
+		dataSourceServerBackupsBackupSizeKey,
+		dataSourceServerBackupsBackupTypeKey,
+	}
+)
+
+// dataSourceServerBackups retrieves information about a server's backups.
+func dataSourceServerBackups() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceServerBackupsBackupsKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server's backups",
+				Elem:        dataSourceServerBackupElem(),
+			},
+			dataSourceServerBackupsFilterKey: dataSourceFilterSchema(nil),
+			dataSourceServerBackupsIdsKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server's backup identifiers",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			dataSourceServerBackupsServerIDKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The server identifier",
+				ForceNew:    true,
+			},
+		},
+
+		Read: dataSourceServerBackupsRead,
+	}
+}
+
+// dataSourceServerBackupsRead reads information about a server's backups.
+func dataSourceServerBackupsRead(d *schema.ResourceData, m interface{}) error {
+	filters, err := dataSourceFilterRead(d, dataSourceServerBackupsFilterNames, nil)
+
+	if err != nil {
+		return err
+	}
+
+	clientSettings := m.(clouddk.ClientSettings)
+
+	serverID := d.Get(dataSourceServerBackupsServerIDKey).(string)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/backups", serverID), new(bytes.Buffer))
+
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return err
+	} else if res.StatusCode != 200 {
+		return fmt.Errorf("Failed to read the information about the backups - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	unfilteredBackups := clouddk.BackupListBody{}
+	err = json.NewDecoder(res.Body).Decode(&unfilteredBackups)
+
+	if err != nil {
+		return err
+	}
+
+	backups := make(clouddk.BackupListBody, 0, len(unfilteredBackups))
+
+	for _, v := range unfilteredBackups {
+		attributes := map[string]string{
+			dataSourceServerBackupsBackupCreatedAtKey: v.CreatedAt,
+			dataSourceServerBackupsBackupIDKey:        v.Identifier,
+			dataSourceServerBackupsBackupLabelKey:     v.Label,
+			dataSourceServerBackupsBackupSizeKey:      strconv.Itoa(int(v.Size)),
+			dataSourceServerBackupsBackupTypeKey:      v.Type,
+		}
+
+		if dataSourceFilterMatch(filters, attributes) {
+			backups = append(backups, v)
+		}
+	}
+
+	ids := make([]interface{}, len(backups))
+	objects := make([]interface{}, len(backups))
+
+	for i, v := range backups {
+		ids[i] = v.Identifier
+		objects[i] = map[string]interface{}{
+			dataSourceServerBackupsBackupCreatedAtKey: v.CreatedAt,
+			dataSourceServerBackupsBackupIDKey:        v.Identifier,
+			dataSourceServerBackupsBackupLabelKey:     v.Label,
+			dataSourceServerBackupsBackupSizeKey:      int(v.Size),
+			dataSourceServerBackupsBackupTypeKey:      v.Type,
+		}
+	}
+
+	d.SetId(serverID)
+
+	d.Set(dataSourceServerBackupsBackupsKey, objects)
+	d.Set(dataSourceServerBackupsIdsKey, ids)
+
+	return nil
+}
+
+// dataSourceServerBackupElem returns the schema for a backup object.
+func dataSourceServerBackupElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceServerBackupsBackupCreatedAtKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation timestamp",
+			},
+			dataSourceServerBackupsBackupIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The backup identifier",
+			},
+			dataSourceServerBackupsBackupLabelKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The backup label",
+			},
+			dataSourceServerBackupsBackupSizeKey: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The backup size in gigabytes",
+			},
+			dataSourceServerBackupsBackupTypeKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The backup type (e.g. automatic or manual)",
+			},
+		},
+	}
+}
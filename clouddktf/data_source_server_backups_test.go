@@ -0,0 +1,46 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+)
+
+// TestDataSourceServerBackupsInstantiation tests whether the dataSourceServerBackups instance can be instantiated.
+func TestDataSourceServerBackupsInstantiation(t *testing.T) {
+	s := dataSourceServerBackups()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceServerBackups")
+	}
+}
+
+// TestDataSourceServerBackupsSchema tests the dataSourceServerBackups schema.
+func TestDataSourceServerBackupsSchema(t *testing.T) {
+	s := dataSourceServerBackups()
+
+	if s.Schema[dataSourceServerBackupsServerIDKey] == nil {
+		t.Fatalf("Error in dataSourceServerBackups.Schema: Missing argument \"%s\"", dataSourceServerBackupsServerIDKey)
+	}
+
+	if s.Schema[dataSourceServerBackupsServerIDKey].Required != true {
+		t.Fatalf("Error in dataSourceServerBackups.Schema: Argument \"%s\" is not required", dataSourceServerBackupsServerIDKey)
+	}
+
+	attributeKeys := []string{
+		dataSourceServerBackupsBackupsKey,
+		dataSourceServerBackupsIdsKey,
+	}
+
+	for _, v := range attributeKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourceServerBackups.Schema: Missing attribute \"%s\"", v)
+		}
+
+		if s.Schema[v].Computed != true {
+			t.Fatalf("Error in dataSourceServerBackups.Schema: Attribute \"%s\" is not computed", v)
+		}
+	}
+}
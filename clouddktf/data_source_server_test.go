@@ -21,12 +21,20 @@ func TestDataSourceServerInstantiation(t *testing.T) {
 func TestDataSourceServerSchema(t *testing.T) {
 	s := dataSourceServer()
 
-	if s.Schema[dataSourceServerIDKey] == nil {
-		t.Fatalf("Error in dataSourceServer.Schema: Missing argument \"%s\"", dataSourceServerIDKey)
+	lookupKeys := []string{
+		dataSourceServerHostnameKey,
+		dataSourceServerIDKey,
+		dataSourceServerLabelKey,
 	}
 
-	if s.Schema[dataSourceServerIDKey].Required != true {
-		t.Fatalf("Error in dataSourceServer.Schema: Argument \"%s\" is not required", dataSourceServerIDKey)
+	for _, v := range lookupKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourceServer.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Optional != true || s.Schema[v].Computed != true {
+			t.Fatalf("Error in dataSourceServer.Schema: Argument \"%s\" is not optional and computed", v)
+		}
 	}
 
 	attributeKeys := []string{
@@ -36,8 +44,11 @@ func TestDataSourceServerSchema(t *testing.T) {
 		dataSourceServerDiskLabelsKey,
 		dataSourceServerDiskPrimaryKey,
 		dataSourceServerDiskSizesKey,
+		dataSourceServerDisksKey,
 		dataSourceServerHostnameKey,
 		dataSourceServerLabelKey,
+		dataSourceServerLocationIDKey,
+		dataSourceServerLocationNameKey,
 		dataSourceServerMemoryKey,
 		dataSourceServerNetworkInterfaceAddressesKey,
 		dataSourceServerNetworkInterfaceDefaultFirewallRulesKey,
@@ -53,10 +64,10 @@ func TestDataSourceServerSchema(t *testing.T) {
 		dataSourceServerNetworkInterfaceNetworksKey,
 		dataSourceServerNetworkInterfacePrimaryKey,
 		dataSourceServerNetworkInterfaceRateLimitsKey,
-		dataSourceServerLocationIDKey,
-		dataSourceServerLocationNameKey,
+		dataSourceServerNetworkInterfacesKey,
 		dataSourceServerPackageIDKey,
 		dataSourceServerPackageNameKey,
+		dataSourceServerPrimaryIPv4AddressKey,
 		dataSourceServerTemplateIDKey,
 		dataSourceServerTemplateNameKey,
 	}
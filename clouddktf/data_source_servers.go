@@ -25,30 +25,38 @@ const (
 	dataSourceServersLocationNamesKey  = "location_names"
 	dataSourceServersPackageIdsKey     = "package_ids"
 	dataSourceServersPackageNamesKey   = "package_names"
+	dataSourceServersServersKey        = "servers"
 	dataSourceServersTemplateIdsKey    = "template_ids"
 	dataSourceServersTemplateNamesKey  = "template_names"
 )
 
+var (
+	dataSourceServersFilterNames = []string{
+		dataSourceServerHostnameKey,
+		dataSourceServerIDKey,
+		dataSourceServerLabelKey,
+		dataSourceServerLocationIDKey,
+		dataSourceServerLocationNameKey,
+		dataSourceServerPackageIDKey,
+		dataSourceServerPackageNameKey,
+		dataSourceServerTemplateIDKey,
+		dataSourceServerTemplateNameKey,
+	}
+)
+
 // dataSourceServers retrieves a list of servers.
 func dataSourceServers() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
-			dataSourceServersFilterKey: {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						dataSourceServersFilterHostnameKey: {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Default:     "",
-							Description: "The hostname filter",
-							ForceNew:    true,
-						},
-					},
+			dataSourceServersFilterKey: dataSourceFilterSchema(map[string]*schema.Schema{
+				dataSourceServersFilterHostnameKey: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: "The hostname filter",
+					Deprecated:  "Use the 'name' and 'values' arguments instead",
 				},
-				MaxItems: 1,
-			},
+			}),
 			dataSourceServersHostnamesKey: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -84,6 +92,12 @@ func dataSourceServers() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			dataSourceServersServersKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The servers",
+				Elem:        dataSourceServerElem(),
+			},
 			dataSourceServersTemplateIdsKey: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -102,12 +116,22 @@ func dataSourceServers() *schema.Resource {
 
 // dataSourceServersRead reads information about servers.
 func dataSourceServersRead(d *schema.ResourceData, m interface{}) error {
-	filter := d.Get(dataSourceServersFilterKey).([]interface{})
+	filters, err := dataSourceFilterRead(d, dataSourceServersFilterNames, []string{dataSourceServersFilterHostnameKey})
+
+	if err != nil {
+		return err
+	}
+
 	filterHostname := ""
 
-	if len(filter) > 0 {
-		filterData := filter[0].(map[string]interface{})
-		filterHostname = filterData[dataSourceServersFilterHostnameKey].(string)
+	for _, v := range d.Get(dataSourceServersFilterKey).([]interface{}) {
+		if block, ok := v.(map[string]interface{}); ok && len(block[dataSourceServersFilterHostnameKey].(string)) > 0 {
+			filterHostname = block[dataSourceServersFilterHostnameKey].(string)
+		}
+	}
+
+	if len(filterHostname) == 0 {
+		filterHostname = dataSourceFilterServerSideValue(filters, dataSourceServerHostnameKey)
 	}
 
 	// Prepare the relative path based on the filters.
@@ -134,13 +158,33 @@ func dataSourceServersRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Failed to read the information about the servers - Reason: The API responded with HTTP %s", res.Status)
 	}
 
-	list := make(clouddk.ServerListBody, 0)
-	err = json.NewDecoder(res.Body).Decode(&list)
+	unfilteredList := make(clouddk.ServerListBody, 0)
+	err = json.NewDecoder(res.Body).Decode(&unfilteredList)
 
 	if err != nil {
 		return err
 	}
 
+	list := make(clouddk.ServerListBody, 0, len(unfilteredList))
+
+	for _, v := range unfilteredList {
+		attributes := map[string]string{
+			dataSourceServerHostnameKey:     v.Hostname,
+			dataSourceServerIDKey:           v.Identifier,
+			dataSourceServerLabelKey:        v.Label,
+			dataSourceServerLocationIDKey:   v.Location.Identifier,
+			dataSourceServerLocationNameKey: v.Location.Name,
+			dataSourceServerPackageIDKey:    v.Package.Identifier,
+			dataSourceServerPackageNameKey:  v.Package.Name,
+			dataSourceServerTemplateIDKey:   v.Template.Identifier,
+			dataSourceServerTemplateNameKey: v.Template.Name,
+		}
+
+		if dataSourceFilterMatch(filters, attributes) {
+			list = append(list, v)
+		}
+	}
+
 	hostnames := make([]interface{}, len(list))
 	ids := make([]interface{}, len(list))
 	labels := make([]interface{}, len(list))
@@ -148,6 +192,7 @@ func dataSourceServersRead(d *schema.ResourceData, m interface{}) error {
 	locationNames := make([]interface{}, len(list))
 	packageIds := make([]interface{}, len(list))
 	packageNames := make([]interface{}, len(list))
+	servers := make([]interface{}, len(list))
 	templateIds := make([]interface{}, len(list))
 	templateNames := make([]interface{}, len(list))
 
@@ -159,6 +204,7 @@ func dataSourceServersRead(d *schema.ResourceData, m interface{}) error {
 		locationNames[i] = v.Location.Name
 		packageIds[i] = v.Package.Identifier
 		packageNames[i] = v.Package.Name
+		servers[i] = dataSourceServerFlatten(&list[i])
 		templateIds[i] = v.Template.Identifier
 		templateNames[i] = v.Template.Name
 	}
@@ -172,6 +218,7 @@ func dataSourceServersRead(d *schema.ResourceData, m interface{}) error {
 	d.Set(dataSourceServersLocationNamesKey, locationNames)
 	d.Set(dataSourceServersPackageIdsKey, packageIds)
 	d.Set(dataSourceServersPackageNamesKey, packageNames)
+	d.Set(dataSourceServersServersKey, servers)
 	d.Set(dataSourceServersTemplateIdsKey, templateIds)
 	d.Set(dataSourceServersTemplateNamesKey, templateNames)
 
@@ -31,6 +31,7 @@ func TestDataSourceServersSchema(t *testing.T) {
 		dataSourceServersLocationNamesKey,
 		dataSourceServersPackageIdsKey,
 		dataSourceServersPackageNamesKey,
+		dataSourceServersServersKey,
 		dataSourceServersTemplateIdsKey,
 		dataSourceServersTemplateNamesKey,
 	}
@@ -62,10 +63,6 @@ func TestDataSourceServersSchemaFilter(t *testing.T) {
 		t.Fatalf("Error in dataSourceServers.Schema: Block \"%s\" is not a list", dataSourceServersFilterKey)
 	}
 
-	if s.Schema[dataSourceServersFilterKey].MaxItems != 1 {
-		t.Fatalf("Error in dataSourceServers.Schema: Block \"%s\" is not limited to a single definition", dataSourceServersFilterKey)
-	}
-
 	if s.Schema[dataSourceServersFilterKey].Elem == nil {
 		t.Fatalf("Error in dataSourceServers.Schema: Missing element for block \"%s\"", dataSourceServersFilterKey)
 	}
@@ -0,0 +1,159 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	dataSourceSSHKeysFilterKey            = "filter"
+	dataSourceSSHKeysIdsKey               = "ids"
+	dataSourceSSHKeysNamesKey             = "names"
+	dataSourceSSHKeysSSHKeyFingerprintKey = "fingerprint"
+	dataSourceSSHKeysSSHKeyIDKey          = "id"
+	dataSourceSSHKeysSSHKeyNameKey        = "name"
+	dataSourceSSHKeysSSHKeyPublicKeyKey   = "public_key"
+	dataSourceSSHKeysSSHKeysKey           = "ssh_keys"
+)
+
+var (
+	dataSourceSSHKeysFilterNames = []string{
+		dataSourceSSHKeysSSHKeyFingerprintKey,
+		dataSourceSSHKeysSSHKeyIDKey,
+		dataSourceSSHKeysSSHKeyNameKey,
+	}
+)
+
+// dataSourceSSHKeys retrieves a list of SSH keys.
+func dataSourceSSHKeys() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceSSHKeysFilterKey: dataSourceFilterSchema(nil),
+			dataSourceSSHKeysIdsKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The SSH key identifiers",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			dataSourceSSHKeysNamesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The SSH key names",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			dataSourceSSHKeysSSHKeysKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The SSH keys",
+				Elem:        dataSourceSSHKeyElem(),
+			},
+		},
+
+		Read: dataSourceSSHKeysRead,
+	}
+}
+
+// dataSourceSSHKeysRead reads information about SSH keys.
+func dataSourceSSHKeysRead(d *schema.ResourceData, m interface{}) error {
+	filters, err := dataSourceFilterRead(d, dataSourceSSHKeysFilterNames, nil)
+
+	if err != nil {
+		return err
+	}
+
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", "ssh-keys", new(bytes.Buffer))
+
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return err
+	} else if res.StatusCode != 200 {
+		return fmt.Errorf("Failed to read the information about the SSH keys - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	unfilteredList := clouddk.SSHKeyListBody{}
+	err = json.NewDecoder(res.Body).Decode(&unfilteredList)
+
+	if err != nil {
+		return err
+	}
+
+	ids := make([]interface{}, 0, len(unfilteredList))
+	names := make([]interface{}, 0, len(unfilteredList))
+	sshKeys := make([]interface{}, 0, len(unfilteredList))
+
+	for _, v := range unfilteredList {
+		// Keys which cannot be parsed are still listed but without a fingerprint.
+		fingerprint, _ := resourceSSHKeyFingerprint(v.PublicKey)
+
+		attributes := map[string]string{
+			dataSourceSSHKeysSSHKeyFingerprintKey: fingerprint,
+			dataSourceSSHKeysSSHKeyIDKey:          v.Identifier,
+			dataSourceSSHKeysSSHKeyNameKey:        v.Name,
+		}
+
+		if !dataSourceFilterMatch(filters, attributes) {
+			continue
+		}
+
+		ids = append(ids, v.Identifier)
+		names = append(names, v.Name)
+		sshKeys = append(sshKeys, map[string]interface{}{
+			dataSourceSSHKeysSSHKeyFingerprintKey: fingerprint,
+			dataSourceSSHKeysSSHKeyIDKey:          v.Identifier,
+			dataSourceSSHKeysSSHKeyNameKey:        v.Name,
+			dataSourceSSHKeysSSHKeyPublicKeyKey:   v.PublicKey,
+		})
+	}
+
+	d.SetId("ssh_keys")
+
+	d.Set(dataSourceSSHKeysIdsKey, ids)
+	d.Set(dataSourceSSHKeysNamesKey, names)
+	d.Set(dataSourceSSHKeysSSHKeysKey, sshKeys)
+
+	return nil
+}
+
+// dataSourceSSHKeyElem returns the schema for an SSH key object.
+func dataSourceSSHKeyElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceSSHKeysSSHKeyFingerprintKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 fingerprint of the public key",
+			},
+			dataSourceSSHKeysSSHKeyIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SSH key identifier",
+			},
+			dataSourceSSHKeysSSHKeyNameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SSH key name",
+			},
+			dataSourceSSHKeysSSHKeyPublicKeyKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The public key in the authorized_keys format",
+			},
+		},
+	}
+}
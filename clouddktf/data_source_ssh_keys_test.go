@@ -0,0 +1,39 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+)
+
+// TestDataSourceSSHKeysInstantiation tests whether the dataSourceSSHKeys instance can be instantiated.
+func TestDataSourceSSHKeysInstantiation(t *testing.T) {
+	s := dataSourceSSHKeys()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceSSHKeys")
+	}
+}
+
+// TestDataSourceSSHKeysSchema tests the dataSourceSSHKeys schema.
+func TestDataSourceSSHKeysSchema(t *testing.T) {
+	s := dataSourceSSHKeys()
+
+	attributeKeys := []string{
+		dataSourceSSHKeysIdsKey,
+		dataSourceSSHKeysNamesKey,
+		dataSourceSSHKeysSSHKeysKey,
+	}
+
+	for _, v := range attributeKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourceSSHKeys.Schema: Missing attribute \"%s\"", v)
+		}
+
+		if s.Schema[v].Computed != true {
+			t.Fatalf("Error in dataSourceSSHKeys.Schema: Attribute \"%s\" is not computed", v)
+		}
+	}
+}
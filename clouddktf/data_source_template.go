@@ -0,0 +1,209 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	dataSourceTemplateIDKey                = "id"
+	dataSourceTemplateMostRecentKey        = "most_recent"
+	dataSourceTemplateNameKey              = "name"
+	dataSourceTemplateOSFamilyKey          = "os_family"
+	dataSourceTemplateVersionConstraintKey = "version_constraint"
+	dataSourceTemplateVersionKey           = "version"
+)
+
+var (
+	dataSourceTemplateNameRegexp = regexp.MustCompile(`^\s*([A-Za-z][A-Za-z ]*?)\s+v?(\d+(?:\.\d+)*)`)
+)
+
+// dataSourceTemplateCandidate describes a template with a parsed name.
+type dataSourceTemplateCandidate struct {
+	Template clouddk.TemplateBody
+	OSFamily string
+	Version  *version.Version
+}
+
+// dataSourceTemplate retrieves information about a single template.
+func dataSourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceTemplateIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The template identifier",
+			},
+			dataSourceTemplateMostRecentKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to select the most recent template, if multiple templates match",
+			},
+			dataSourceTemplateNameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The template name",
+			},
+			dataSourceTemplateOSFamilyKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The operating system family",
+			},
+			dataSourceTemplateVersionConstraintKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The version constraint",
+			},
+			dataSourceTemplateVersionKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating system version",
+			},
+		},
+
+		Read: dataSourceTemplateRead,
+	}
+}
+
+// dataSourceTemplateRead reads information about a single template.
+func dataSourceTemplateRead(d *schema.ResourceData, m interface{}) error {
+	mostRecent := d.Get(dataSourceTemplateMostRecentKey).(bool)
+	osFamily := dataSourceTemplateOSFamily(d.Get(dataSourceTemplateOSFamilyKey).(string))
+	versionConstraint := d.Get(dataSourceTemplateVersionConstraintKey).(string)
+
+	var constraints version.Constraints
+
+	if len(versionConstraint) > 0 {
+		var err error
+		constraints, err = version.NewConstraint(versionConstraint)
+
+		if err != nil {
+			return fmt.Errorf("Invalid version constraint '%s' - Reason: %s", versionConstraint, err.Error())
+		}
+	}
+
+	list, err := dataSourceTemplatesList(m, "")
+
+	if err != nil {
+		return err
+	}
+
+	candidates := make([]dataSourceTemplateCandidate, 0, len(list))
+
+	for _, v := range list {
+		c := dataSourceTemplateParse(v)
+
+		if len(osFamily) > 0 && c.OSFamily != osFamily {
+			continue
+		}
+
+		if constraints != nil && (c.Version == nil || !constraints.Check(c.Version)) {
+			continue
+		}
+
+		candidates = append(candidates, c)
+	}
+
+	criteria := fmt.Sprintf("%s = %q, %s = %q", dataSourceTemplateOSFamilyKey, osFamily, dataSourceTemplateVersionConstraintKey, versionConstraint)
+
+	if len(candidates) == 0 {
+		return fmt.Errorf("No template matches the criteria (%s)", criteria)
+	} else if len(candidates) > 1 && !mostRecent {
+		return fmt.Errorf("Found %d templates matching the criteria (%s) - Please refine the criteria or set '%s' to true", len(candidates), criteria, dataSourceTemplateMostRecentKey)
+	}
+
+	dataSourceTemplateSort(candidates)
+
+	c := candidates[0]
+
+	d.SetId(c.Template.Identifier)
+
+	d.Set(dataSourceTemplateIDKey, c.Template.Identifier)
+	d.Set(dataSourceTemplateNameKey, c.Template.Name)
+	d.Set(dataSourceTemplateOSFamilyKey, c.OSFamily)
+
+	if c.Version != nil {
+		d.Set(dataSourceTemplateVersionKey, c.Version.Original())
+	} else {
+		d.Set(dataSourceTemplateVersionKey, "")
+	}
+
+	return nil
+}
+
+// dataSourceTemplateNewer determines whether a template is more recent than another template.
+// Templates without a version are considered to be older than any template with a version.
+func dataSourceTemplateNewer(a *dataSourceTemplateCandidate, b *dataSourceTemplateCandidate) bool {
+	if a.Version == nil || b.Version == nil {
+		return a.Version != nil
+	}
+
+	return a.Version.GreaterThan(b.Version)
+}
+
+// dataSourceTemplateSort sorts the templates from the most recent to the oldest.
+// Templates with the same version are ordered by their identifier to ensure that the selection is deterministic.
+func dataSourceTemplateSort(candidates []dataSourceTemplateCandidate) {
+	sort.Slice(candidates, func(i, j int) bool {
+		if dataSourceTemplateNewer(&candidates[i], &candidates[j]) {
+			return true
+		} else if dataSourceTemplateNewer(&candidates[j], &candidates[i]) {
+			return false
+		}
+
+		return candidates[i].Template.Identifier < candidates[j].Template.Identifier
+	})
+}
+
+// dataSourceTemplateParse determines the operating system family and version of a template.
+// The name is parsed first (e.g. "Ubuntu 20.04 x64") and the identifier is used as a fallback (e.g. "ubuntu-20.04-x64").
+func dataSourceTemplateParse(template clouddk.TemplateBody) dataSourceTemplateCandidate {
+	c := dataSourceTemplateCandidate{Template: template}
+
+	for _, s := range []string{template.Name, strings.Replace(template.Identifier, "-", " ", -1)} {
+		matches := dataSourceTemplateNameRegexp.FindStringSubmatch(s)
+
+		if matches == nil {
+			continue
+		}
+
+		v, err := version.NewVersion(matches[2])
+
+		if err != nil {
+			continue
+		}
+
+		c.OSFamily = dataSourceTemplateOSFamily(matches[1])
+		c.Version = v
+
+		return c
+	}
+
+	return c
+}
+
+// dataSourceTemplateOSFamily normalizes an operating system name to the family, which is the first word in lower case.
+// This ensures that names like "Windows Server" and identifiers like "windows-server" both result in "windows".
+func dataSourceTemplateOSFamily(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	})
+
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return fields[0]
+}
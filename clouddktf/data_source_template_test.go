@@ -0,0 +1,140 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+)
+
+// TestDataSourceTemplateInstantiation tests whether the dataSourceTemplate instance can be instantiated.
+func TestDataSourceTemplateInstantiation(t *testing.T) {
+	s := dataSourceTemplate()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceTemplate")
+	}
+}
+
+// TestDataSourceTemplateSchema tests the dataSourceTemplate schema.
+func TestDataSourceTemplateSchema(t *testing.T) {
+	s := dataSourceTemplate()
+
+	argumentKeys := []string{
+		dataSourceTemplateMostRecentKey,
+		dataSourceTemplateOSFamilyKey,
+		dataSourceTemplateVersionConstraintKey,
+	}
+
+	for _, v := range argumentKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourceTemplate.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Optional != true {
+			t.Fatalf("Error in dataSourceTemplate.Schema: Argument \"%s\" is not optional", v)
+		}
+	}
+
+	attributeKeys := []string{
+		dataSourceTemplateIDKey,
+		dataSourceTemplateNameKey,
+		dataSourceTemplateOSFamilyKey,
+		dataSourceTemplateVersionKey,
+	}
+
+	for _, v := range attributeKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in dataSourceTemplate.Schema: Missing attribute \"%s\"", v)
+		}
+
+		if s.Schema[v].Computed != true {
+			t.Fatalf("Error in dataSourceTemplate.Schema: Attribute \"%s\" is not computed", v)
+		}
+	}
+}
+
+// TestDataSourceTemplateParse tests the dataSourceTemplateParse function.
+func TestDataSourceTemplateParse(t *testing.T) {
+	tests := []struct {
+		template clouddk.TemplateBody
+		osFamily string
+		version  string
+	}{
+		{clouddk.TemplateBody{Identifier: "ubuntu-20.04-x64", Name: "Ubuntu 20.04 x64"}, "ubuntu", "20.04"},
+		{clouddk.TemplateBody{Identifier: "debian-10-x64", Name: ""}, "debian", "10"},
+		{clouddk.TemplateBody{Identifier: "windows-2019", Name: "Windows Server 2019"}, "windows", "2019"},
+		{clouddk.TemplateBody{Identifier: "windows-server-2019", Name: ""}, "windows", "2019"},
+		{clouddk.TemplateBody{Identifier: "custom", Name: "Custom"}, "", ""},
+	}
+
+	for _, v := range tests {
+		c := dataSourceTemplateParse(v.template)
+
+		if c.OSFamily != v.osFamily {
+			t.Fatalf("Error in dataSourceTemplateParse: Expected OS family \"%s\" for \"%s\" but got \"%s\"", v.osFamily, v.template.Identifier, c.OSFamily)
+		}
+
+		if v.version == "" {
+			if c.Version != nil {
+				t.Fatalf("Error in dataSourceTemplateParse: Expected no version for \"%s\"", v.template.Identifier)
+			}
+		} else if c.Version == nil || c.Version.Original() != v.version {
+			t.Fatalf("Error in dataSourceTemplateParse: Expected version \"%s\" for \"%s\"", v.version, v.template.Identifier)
+		}
+	}
+}
+
+// TestDataSourceTemplateNewer tests the dataSourceTemplateNewer function.
+func TestDataSourceTemplateNewer(t *testing.T) {
+	a := dataSourceTemplateParse(clouddk.TemplateBody{Identifier: "ubuntu-20.04-x64"})
+	b := dataSourceTemplateParse(clouddk.TemplateBody{Identifier: "ubuntu-18.04-x64"})
+	c := dataSourceTemplateParse(clouddk.TemplateBody{Identifier: "custom"})
+
+	if !dataSourceTemplateNewer(&a, &b) || dataSourceTemplateNewer(&b, &a) {
+		t.Fatalf("Error in dataSourceTemplateNewer: Ubuntu 20.04 is not newer than Ubuntu 18.04")
+	}
+
+	if !dataSourceTemplateNewer(&b, &c) || dataSourceTemplateNewer(&c, &b) {
+		t.Fatalf("Error in dataSourceTemplateNewer: A template without a version is not older")
+	}
+}
+
+// TestDataSourceTemplateOSFamily tests the dataSourceTemplateOSFamily function.
+func TestDataSourceTemplateOSFamily(t *testing.T) {
+	tests := map[string]string{
+		"":               "",
+		"Ubuntu":         "ubuntu",
+		"Windows Server": "windows",
+		"windows-server": "windows",
+		"windows":        "windows",
+	}
+
+	for input, expected := range tests {
+		if actual := dataSourceTemplateOSFamily(input); actual != expected {
+			t.Fatalf("Error in dataSourceTemplateOSFamily: Expected \"%s\" for \"%s\" but got \"%s\"", expected, input, actual)
+		}
+	}
+}
+
+// TestDataSourceTemplateSort tests whether the dataSourceTemplateSort function breaks ties by identifier.
+func TestDataSourceTemplateSort(t *testing.T) {
+	candidates := []dataSourceTemplateCandidate{
+		dataSourceTemplateParse(clouddk.TemplateBody{Identifier: "ubuntu-18.04-x64"}),
+		dataSourceTemplateParse(clouddk.TemplateBody{Identifier: "ubuntu-20.04-x64-minimal"}),
+		dataSourceTemplateParse(clouddk.TemplateBody{Identifier: "ubuntu-20.04-x64"}),
+	}
+
+	dataSourceTemplateSort(candidates)
+
+	expected := []string{"ubuntu-20.04-x64", "ubuntu-20.04-x64-minimal", "ubuntu-18.04-x64"}
+
+	for i, v := range expected {
+		if candidates[i].Template.Identifier != v {
+			t.Fatalf("Error in dataSourceTemplateSort: Expected \"%s\" at index %d but got \"%s\"", v, i, candidates[i].Template.Identifier)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
 	"net/url"
 
@@ -16,32 +17,28 @@ import (
 )
 
 const (
-	dataSourceTemplatesFilterKey     = "filter"
-	dataSourceTemplatesFilterNameKey = "name"
-	dataSourceTemplatesIdsKey        = "ids"
-	dataSourceTemplatesNamesKey      = "names"
+	dataSourceTemplatesFilterKey       = "filter"
+	dataSourceTemplatesFilterNameKey   = "name"
+	dataSourceTemplatesIdsKey          = "ids"
+	dataSourceTemplatesNamesKey        = "names"
+	dataSourceTemplatesTemplateIDKey   = "id"
+	dataSourceTemplatesTemplateNameKey = "name"
+	dataSourceTemplatesTemplatesKey    = "templates"
+)
+
+var (
+	dataSourceTemplatesFilterNames = []string{
+		dataSourceTemplatesTemplateIDKey,
+		dataSourceTemplatesTemplateNameKey,
+	}
 )
 
 // dataSourceTemplates retrieves a list of OS templates.
 func dataSourceTemplates() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
-			dataSourceTemplatesFilterKey: {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						dataSourceTemplatesFilterNameKey: {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Default:     "",
-							Description: "The name filter",
-							ForceNew:    true,
-						},
-					},
-				},
-				MaxItems: 1,
-			},
+			// The name argument doubles as the legacy substring filter, which is why it must remain optional.
+			dataSourceTemplatesFilterKey: dataSourceFilterSchema(map[string]*schema.Schema{}),
 			dataSourceTemplatesIdsKey: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -52,6 +49,12 @@ func dataSourceTemplates() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			dataSourceTemplatesTemplatesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The templates",
+				Elem:        dataSourceTemplateElem(),
+			},
 		},
 
 		Read: dataSourceTemplatesRead,
@@ -60,57 +63,116 @@ func dataSourceTemplates() *schema.Resource {
 
 // dataSourceTemplatesRead reads information about OS templates.
 func dataSourceTemplatesRead(d *schema.ResourceData, m interface{}) error {
-	filter := d.Get(dataSourceTemplatesFilterKey).([]interface{})
-	filterName := ""
+	filters, err := dataSourceFilterRead(d, dataSourceTemplatesFilterNames, []string{dataSourceTemplatesFilterNameKey})
 
-	if len(filter) > 0 {
-		filterData := filter[0].(map[string]interface{})
-		filterName = filterData[dataSourceTemplatesFilterNameKey].(string)
+	if err != nil {
+		return err
 	}
 
-	// Prepare the relative path based on the filters.
-	path := "templates?per-page=1000"
+	filterName := ""
 
-	if len(filterName) > 0 {
-		path = fmt.Sprintf("%s&name=%s", path, url.QueryEscape(filterName))
-	}
+	// Filters without any values are treated as substring matches on the template name.
+	for _, v := range d.Get(dataSourceTemplatesFilterKey).([]interface{}) {
+		if block, ok := v.(map[string]interface{}); ok && len(block[dataSourceFilterValuesKey].([]interface{})) == 0 {
+			filterName = block[dataSourceTemplatesFilterNameKey].(string)
 
-	// Retrieve the list of templates by invoking the API action.
-	clientSettings := m.(clouddk.ClientSettings)
-	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", path, new(bytes.Buffer))
+			log.Printf("[WARN] The filter '%s' without values performs a deprecated substring match on the template name - Use the '%s' and '%s' arguments instead", filterName, dataSourceFilterRegexKey, dataSourceFilterValuesKey)
+		}
+	}
 
-	if err != nil {
-		return err
+	if len(filterName) == 0 {
+		filterName = dataSourceFilterServerSideValue(filters, dataSourceTemplatesTemplateNameKey)
 	}
 
-	client := &http.Client{}
-	res, err := client.Do(req)
+	unfilteredList, err := dataSourceTemplatesList(m, filterName)
 
 	if err != nil {
 		return err
-	} else if res.StatusCode != 200 {
-		return fmt.Errorf("Failed to read the information about the templates - Reason: The API responded with HTTP %s", res.Status)
 	}
 
-	list := make(clouddk.TemplateListBody, 0)
-	err = json.NewDecoder(res.Body).Decode(&list)
+	list := make(clouddk.TemplateListBody, 0, len(unfilteredList))
 
-	if err != nil {
-		return err
+	for _, v := range unfilteredList {
+		attributes := map[string]string{
+			dataSourceTemplatesTemplateIDKey:   v.Identifier,
+			dataSourceTemplatesTemplateNameKey: v.Name,
+		}
+
+		if dataSourceFilterMatch(filters, attributes) {
+			list = append(list, v)
+		}
 	}
 
 	ids := make([]interface{}, len(list))
 	names := make([]interface{}, len(list))
+	templates := make([]interface{}, len(list))
 
 	for i, v := range list {
 		ids[i] = v.Identifier
 		names[i] = v.Name
+		templates[i] = map[string]interface{}{
+			dataSourceTemplatesTemplateIDKey:   v.Identifier,
+			dataSourceTemplatesTemplateNameKey: v.Name,
+		}
 	}
 
 	d.SetId("templates")
 
 	d.Set(dataSourceTemplatesIdsKey, ids)
 	d.Set(dataSourceTemplatesNamesKey, names)
+	d.Set(dataSourceTemplatesTemplatesKey, templates)
 
 	return nil
 }
+
+// dataSourceTemplatesList retrieves the list of templates, optionally filtered by a substring of the name.
+func dataSourceTemplatesList(m interface{}, name string) (clouddk.TemplateListBody, error) {
+	path := "templates?per-page=1000"
+
+	if len(name) > 0 {
+		path = fmt.Sprintf("%s&name=%s", path, url.QueryEscape(name))
+	}
+
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", path, new(bytes.Buffer))
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode != 200 {
+		return nil, fmt.Errorf("Failed to read the information about the templates - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	list := make(clouddk.TemplateListBody, 0)
+	err = json.NewDecoder(res.Body).Decode(&list)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// dataSourceTemplateElem returns the schema for a template object.
+func dataSourceTemplateElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceTemplatesTemplateIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The template identifier",
+			},
+			dataSourceTemplatesTemplateNameKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The template name",
+			},
+		},
+	}
+}
@@ -26,6 +26,7 @@ func TestDataSourceTemplatesSchema(t *testing.T) {
 	attributeKeys := []string{
 		dataSourceTemplatesIdsKey,
 		dataSourceTemplatesNamesKey,
+		dataSourceTemplatesTemplatesKey,
 	}
 
 	for _, v := range attributeKeys {
@@ -55,10 +56,6 @@ func TestDataSourceTemplatesSchemaFilter(t *testing.T) {
 		t.Fatalf("Error in dataSourceTemplates.Schema: Block \"%s\" is not a list", dataSourceTemplatesFilterKey)
 	}
 
-	if s.Schema[dataSourceTemplatesFilterKey].MaxItems != 1 {
-		t.Fatalf("Error in dataSourceTemplates.Schema: Block \"%s\" is not limited to a single definition", dataSourceTemplatesFilterKey)
-	}
-
 	if s.Schema[dataSourceTemplatesFilterKey].Elem == nil {
 		t.Fatalf("Error in dataSourceTemplates.Schema: Missing element for block \"%s\"", dataSourceTemplatesFilterKey)
 	}
@@ -0,0 +1,44 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	deletionProtectionKey = "deletion_protection"
+)
+
+var (
+	deletionProtectionDefault = false
+)
+
+// deletionProtectionSchema returns the schema for a deletion_protection argument.
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether to prevent the resource from being deleted (defaults to the provider setting)",
+	}
+}
+
+// deletionProtectionCheck returns an error, if deletion protection is enabled for a resource.
+// The provider default applies to every resource, which does not specify the deletion_protection argument, including existing resources.
+func deletionProtectionCheck(d *schema.ResourceData, resourceType string) error {
+	enabled := deletionProtectionDefault
+
+	if v, ok := d.GetOkExists(deletionProtectionKey); ok {
+		enabled = v.(bool)
+	}
+
+	if !enabled {
+		return nil
+	}
+
+	return fmt.Errorf("Failed to delete the %s (id: %s) - Reason: Deletion protection is enabled (set '%s' to false and apply the change before deleting the %s)", resourceType, d.Id(), deletionProtectionKey, resourceType)
+}
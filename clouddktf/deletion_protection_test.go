@@ -0,0 +1,66 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// TestDeletionProtectionDestroy tests whether the provider default applies to existing resources, which do not specify the argument.
+func TestDeletionProtectionDestroy(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			deletionProtectionKey: deletionProtectionSchema(),
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return deletionProtectionCheck(d, "server")
+		},
+	}
+
+	defer func(v bool) { deletionProtectionDefault = v }(deletionProtectionDefault)
+
+	tests := []struct {
+		attributes map[string]string
+		defaultOn  bool
+		refused    bool
+	}{
+		{map[string]string{}, true, true},
+		{map[string]string{}, false, false},
+		{map[string]string{deletionProtectionKey: "false"}, true, false},
+		{map[string]string{deletionProtectionKey: "true"}, false, true},
+	}
+
+	for _, v := range tests {
+		deletionProtectionDefault = v.defaultOn
+
+		state := &terraform.InstanceState{ID: "1234", Attributes: v.attributes}
+		_, err := r.Apply(state, &terraform.InstanceDiff{Destroy: true}, nil)
+
+		if v.refused && err == nil {
+			t.Fatalf("Deletion was not refused for %v with the provider default %t", v.attributes, v.defaultOn)
+		} else if !v.refused && err != nil {
+			t.Fatalf("Deletion was refused for %v with the provider default %t - Reason: %s", v.attributes, v.defaultOn, err.Error())
+		}
+	}
+}
+
+// TestDeletionProtectionCheck tests whether deletion is refused while deletion protection is enabled.
+func TestDeletionProtectionCheck(t *testing.T) {
+	d := resourceServer().TestResourceData()
+	d.SetId("1234")
+
+	if err := deletionProtectionCheck(d, "server"); err != nil {
+		t.Fatalf("Deletion was refused without deletion protection - Reason: %s", err.Error())
+	}
+
+	d.Set(resourceServerDeletionProtectionKey, true)
+
+	if err := deletionProtectionCheck(d, "server"); err == nil {
+		t.Fatalf("Deletion was not refused with deletion protection")
+	}
+}
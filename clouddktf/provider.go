@@ -6,14 +6,20 @@ package clouddktf
 
 import (
 	"errors"
+	"time"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 const (
-	providerConfigurationEndpoint = "endpoint"
-	providerConfigurationKey      = "key"
+	providerConfigurationCheckAccountQuota          = "check_account_quota"
+	providerConfigurationDeletionProtection         = "deletion_protection"
+	providerConfigurationEndpoint                   = "endpoint"
+	providerConfigurationKey                        = "key"
+	providerConfigurationLockDir                    = "lock_directory"
+	providerConfigurationLockTimeout                = "lock_timeout"
+	providerConfigurationMaxConcurrentServerActions = "max_concurrent_server_actions"
 )
 
 // Provider returns the object for this provider.
@@ -21,6 +27,7 @@ func Provider() *schema.Provider {
 	return &schema.Provider{
 		ConfigureFunc: providerConfigure,
 		DataSourcesMap: map[string]*schema.Resource{
+			"clouddk_account":            dataSourceAccount(),
 			"clouddk_disk":               dataSourceDisk(),
 			"clouddk_disks":              dataSourceDisks(),
 			"clouddk_firewall_rule":      dataSourceFirewallRule(),
@@ -29,18 +36,40 @@ func Provider() *schema.Provider {
 			"clouddk_locations":          dataSourceLocations(),
 			"clouddk_network_interface":  dataSourceNetworkInterface(),
 			"clouddk_network_interfaces": dataSourceNetworkInterfaces(),
+			"clouddk_package":            dataSourcePackage(),
 			"clouddk_packages":           dataSourcePackages(),
 			"clouddk_server":             dataSourceServer(),
+			"clouddk_server_backups":     dataSourceServerBackups(),
 			"clouddk_servers":            dataSourceServers(),
+			"clouddk_ssh_keys":           dataSourceSSHKeys(),
+			"clouddk_template":           dataSourceTemplate(),
 			"clouddk_templates":          dataSourceTemplates(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"clouddk_disk":          resourceDisk(),
-			"clouddk_firewall_rule": resourceFirewallRule(),
-			"clouddk_ip_address":    resourceIPAddress(),
-			"clouddk_server":        resourceServer(),
+			"clouddk_disk":                       resourceDisk(),
+			"clouddk_firewall_rule":              resourceFirewallRule(),
+			"clouddk_ip_address":                 resourceIPAddress(),
+			"clouddk_private_network":            resourcePrivateNetwork(),
+			"clouddk_private_network_attachment": resourcePrivateNetworkAttachment(),
+			"clouddk_reverse_dns":                resourceReverseDNS(),
+			"clouddk_server":                     resourceServer(),
+			"clouddk_server_backup_policy":       resourceServerBackupPolicy(),
+			"clouddk_server_snapshot":            resourceServerSnapshot(),
+			"clouddk_ssh_key":                    resourceSSHKey(),
 		},
 		Schema: map[string]*schema.Schema{
+			providerConfigurationCheckAccountQuota: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to compare the planned creations of servers, disks and IP addresses against the remaining account quotas",
+			},
+			providerConfigurationDeletionProtection: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to enable deletion protection for servers and disks, which do not specify the deletion_protection argument",
+			},
 			providerConfigurationEndpoint: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -52,6 +81,24 @@ func Provider() *schema.Provider {
 				Required:    true,
 				Description: "The API key",
 			},
+			providerConfigurationLockDir: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The directory used for server lock files shared between multiple Terraform processes",
+			},
+			providerConfigurationLockTimeout: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     int(serverLockDefaultTimeout.Seconds()),
+				Description: "The number of seconds to wait for a server lock before timing out",
+			},
+			providerConfigurationMaxConcurrentServerActions: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     serverActionDefaultLimit,
+				Description: "The maximum number of concurrent account-global server actions (create, delete and upgrade)",
+			},
 		},
 	}
 }
@@ -70,6 +117,35 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, errors.New("The API key cannot be an empty string")
 	}
 
+	lockTimeout := d.Get(providerConfigurationLockTimeout).(int)
+
+	if lockTimeout < 1 {
+		return nil, errors.New("The lock timeout must be greater than zero")
+	}
+
+	maxConcurrentServerActions := d.Get(providerConfigurationMaxConcurrentServerActions).(int)
+
+	if maxConcurrentServerActions < 1 {
+		return nil, errors.New("The maximum number of concurrent server actions must be greater than zero")
+	}
+
+	var lockBackend serverLockBackend
+
+	if lockDirectory := d.Get(providerConfigurationLockDir).(string); len(lockDirectory) > 0 {
+		backend, err := newFileServerLockBackend(lockDirectory)
+
+		if err != nil {
+			return nil, err
+		}
+
+		lockBackend = backend
+	}
+
+	serverActions = newServerActionGate(maxConcurrentServerActions, serverActionSpacing)
+	serverLocks = newServerLockManager(lockBackend, time.Duration(lockTimeout)*time.Second)
+	deletionProtectionDefault = d.Get(providerConfigurationDeletionProtection).(bool)
+	accountQuotas = newAccountQuotaChecker(d.Get(providerConfigurationCheckAccountQuota).(bool))
+
 	clientSettings := clouddk.ClientSettings{
 		Endpoint: endpoint,
 		Key:      key,
@@ -19,10 +19,43 @@ func TestProviderInstantiation(t *testing.T) {
 	}
 }
 
+// TestProviderInternalValidate() tests whether the Provider schema passes the internal validation.
+func TestProviderInternalValidate(t *testing.T) {
+	err := Provider().InternalValidate()
+
+	if err != nil {
+		t.Fatalf("Error in Provider: %s", err.Error())
+	}
+}
+
 // TestProviderSchema() tests the Provider schema.
 func TestProviderSchema(t *testing.T) {
 	s := Provider()
 
+	if s.Schema[providerConfigurationCheckAccountQuota] == nil {
+		t.Fatalf("Error in Provider.Schema: Missing argument \"%s\"", providerConfigurationCheckAccountQuota)
+	}
+
+	if s.Schema[providerConfigurationCheckAccountQuota].Optional != true {
+		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not optional", providerConfigurationCheckAccountQuota)
+	}
+
+	if s.Schema[providerConfigurationCheckAccountQuota].Type != schema.TypeBool {
+		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not a boolean", providerConfigurationCheckAccountQuota)
+	}
+
+	if s.Schema[providerConfigurationDeletionProtection] == nil {
+		t.Fatalf("Error in Provider.Schema: Missing argument \"%s\"", providerConfigurationDeletionProtection)
+	}
+
+	if s.Schema[providerConfigurationDeletionProtection].Optional != true {
+		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not optional", providerConfigurationDeletionProtection)
+	}
+
+	if s.Schema[providerConfigurationDeletionProtection].Type != schema.TypeBool {
+		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not a boolean", providerConfigurationDeletionProtection)
+	}
+
 	if s.Schema[providerConfigurationEndpoint] == nil {
 		t.Fatalf("Error in Provider.Schema: Missing argument \"%s\"", providerConfigurationEndpoint)
 	}
@@ -46,4 +79,40 @@ func TestProviderSchema(t *testing.T) {
 	if s.Schema[providerConfigurationKey].Type != schema.TypeString {
 		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not a string", providerConfigurationKey)
 	}
+
+	if s.Schema[providerConfigurationLockDir] == nil {
+		t.Fatalf("Error in Provider.Schema: Missing argument \"%s\"", providerConfigurationLockDir)
+	}
+
+	if s.Schema[providerConfigurationLockDir].Optional != true {
+		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not optional", providerConfigurationLockDir)
+	}
+
+	if s.Schema[providerConfigurationLockDir].Type != schema.TypeString {
+		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not a string", providerConfigurationLockDir)
+	}
+
+	if s.Schema[providerConfigurationLockTimeout] == nil {
+		t.Fatalf("Error in Provider.Schema: Missing argument \"%s\"", providerConfigurationLockTimeout)
+	}
+
+	if s.Schema[providerConfigurationLockTimeout].Optional != true {
+		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not optional", providerConfigurationLockTimeout)
+	}
+
+	if s.Schema[providerConfigurationLockTimeout].Type != schema.TypeInt {
+		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not an integer", providerConfigurationLockTimeout)
+	}
+
+	if s.Schema[providerConfigurationMaxConcurrentServerActions] == nil {
+		t.Fatalf("Error in Provider.Schema: Missing argument \"%s\"", providerConfigurationMaxConcurrentServerActions)
+	}
+
+	if s.Schema[providerConfigurationMaxConcurrentServerActions].Optional != true {
+		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not optional", providerConfigurationMaxConcurrentServerActions)
+	}
+
+	if s.Schema[providerConfigurationMaxConcurrentServerActions].Type != schema.TypeInt {
+		t.Fatalf("Error in Provider.Schema: Argument \"%s\" is not an integer", providerConfigurationMaxConcurrentServerActions)
+	}
 }
@@ -14,10 +14,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+const (
+	resourceDiskDeletionProtectionKey = deletionProtectionKey
+	resourceDiskGracefulShutdownKey   = gracefulShutdownKey
+)
+
 // resourceDisk manages a disk.
 func resourceDisk() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			resourceDiskDeletionProtectionKey: deletionProtectionSchema(),
+			resourceDiskGracefulShutdownKey:   gracefulShutdownSchema("The settings for shutting down the server before the disk is deleted"),
 			dataSourceDiskLabelKey: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -45,9 +52,22 @@ func resourceDisk() *schema.Resource {
 		Read:   resourceDiskRead,
 		Update: resourceDiskUpdate,
 		Delete: resourceDiskDelete,
+
+		CustomizeDiff: resourceDiskCustomizeDiff,
 	}
 }
 
+// resourceDiskCustomizeDiff validates the graceful_shutdown block and reserves the account quota for new disks.
+func resourceDiskCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	err := gracefulShutdownCustomizeDiff(d)
+
+	if err != nil || len(d.Id()) > 0 {
+		return err
+	}
+
+	return accountQuotas.Reserve(m, "", map[string]int{accountQuotaDisks: 1})
+}
+
 // resourceDiskCreate creates a disk.
 func resourceDiskCreate(d *schema.ResourceData, m interface{}) error {
 	clientSettings := m.(clouddk.ClientSettings)
@@ -66,26 +86,20 @@ func resourceDiskCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	var res *http.Response
+
 	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err = resourceServerLock(d, m, serverID)
+	err = resourceServerWithLock(m, serverID, "create disk", func() (err error) {
+		res, err = clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/disks", serverID), reqBody, []int{200}, 60, 10)
 
-	if err != nil {
 		return err
-	}
-
-	res, err := clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/disks", serverID), reqBody, []int{200}, 60, 10)
+	})
 
 	if err != nil {
-		resourceServerUnlock(d, m, serverID)
-
 		return err
 	}
 
-	err = resourceServerUnlock(d, m, serverID)
-
-	if err != nil {
-		return err
-	}
+	accountQuotas.Commit("", map[string]int{accountQuotaDisks: 1})
 
 	disk := clouddk.DiskBody{}
 	err = json.NewDecoder(res.Body).Decode(&disk)
@@ -154,22 +168,14 @@ func resourceDiskUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	var res *http.Response
+
 	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err = resourceServerLock(d, m, serverID)
+	err = resourceServerWithLock(m, serverID, "update disk", func() (err error) {
+		res, err = clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("cloudservers/%s/disks/%s", serverID, diskID), new(bytes.Buffer), []int{200}, 60, 10)
 
-	if err != nil {
 		return err
-	}
-
-	res, resErr := clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("cloudservers/%s/disks/%s", serverID, diskID), new(bytes.Buffer), []int{200}, 60, 10)
-
-	if resErr != nil {
-		resourceServerUnlock(d, m, serverID)
-
-		return resErr
-	}
-
-	err = resourceServerUnlock(d, m, serverID)
+	})
 
 	if err != nil {
 		return err
@@ -187,27 +193,58 @@ func resourceDiskUpdate(d *schema.ResourceData, m interface{}) error {
 
 // resourceDiskDelete deletes an existing disk.
 func resourceDiskDelete(d *schema.ResourceData, m interface{}) error {
+	err := deletionProtectionCheck(d, "disk")
+
+	if err != nil {
+		return err
+	}
+
+	shutdownTimeout, shutdown, err := gracefulShutdownTimeout(d)
+
+	if err != nil {
+		return err
+	}
+
 	clientSettings := m.(clouddk.ClientSettings)
 
 	diskID := d.Id()
 	serverID := d.Get(dataSourceDiskServerIDKey).(string)
 
 	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err := resourceServerLock(d, m, serverID)
+	err = resourceServerWithLock(m, serverID, "delete disk", func() error {
+		running := false
 
-	if err != nil {
-		return err
-	}
+		// Shutting down the server first ensures that the disk is no longer in use, when it is detached.
+		if shutdown {
+			var err error
+			running, err = resourceServerShutdown(m, serverID, shutdownTimeout)
 
-	_, err = clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s/disks/%s", serverID, diskID), new(bytes.Buffer), []int{200, 404}, 60, 10)
+			if err != nil {
+				return err
+			}
+		}
 
-	if err != nil {
-		resourceServerUnlock(d, m, serverID)
+		_, err := clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s/disks/%s", serverID, diskID), new(bytes.Buffer), []int{200, 404}, 60, 10)
 
-		return err
-	}
+		if !running {
+			return err
+		}
+
+		if err == nil {
+			err = resourceServerWaitForTransactions(m, serverID)
+		}
+
+		// The server must be started again regardless of the outcome, as it was running before the disk was detached.
+		startErr := resourceServerStart(m, serverID)
+
+		if err != nil && startErr != nil {
+			return fmt.Errorf("%s - Additionally, failed to start the server (id: %s) again - Reason: %s", err.Error(), serverID, startErr.Error())
+		} else if err != nil {
+			return err
+		}
 
-	err = resourceServerUnlock(d, m, serverID)
+		return startErr
+	})
 
 	if err != nil {
 		return err
@@ -37,6 +37,21 @@ func TestResourceDiskSchema(t *testing.T) {
 		}
 	}
 
+	optionalKeys := []string{
+		resourceDiskDeletionProtectionKey,
+		resourceDiskGracefulShutdownKey,
+	}
+
+	for _, v := range optionalKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourceDisk.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Optional != true {
+			t.Fatalf("Error in resourceDisk.Schema: Argument \"%s\" is not optional", v)
+		}
+	}
+
 	attributeKeys := []string{
 		dataSourceDiskPrimaryKey,
 	}
@@ -67,24 +67,15 @@ func resourceFirewallRuleCreate(d *schema.ResourceData, m interface{}) error {
 
 	serverID := d.Get(dataSourceFirewallRuleServerIDKey).(string)
 	networkInterfaceID := d.Get(dataSourceFirewallRuleNetworkInterfaceIDKey).(string)
-	address := strings.Split(d.Get(dataSourceFirewallRuleAddressKey).(string), "/")
-
-	if len(address) != 2 {
-		return fmt.Errorf("Invalid address '%s' for firewall rule (must be defined as x.x.x.x/x)", d.Get(dataSourceFirewallRuleAddressKey).(string))
-	}
-
-	bits, err := strconv.Atoi(address[1])
+	body, err := resourceFirewallRuleBody(
+		d.Get(dataSourceFirewallRuleAddressKey).(string),
+		d.Get(dataSourceFirewallRuleCommandKey).(string),
+		d.Get(dataSourceFirewallRulePortKey).(string),
+		d.Get(dataSourceFirewallRuleProtocolKey).(string),
+	)
 
 	if err != nil {
-		return fmt.Errorf("Invalid address '%s' for firewall rule (%s)", d.Get(dataSourceFirewallRuleAddressKey).(string), err.Error())
-	}
-
-	body := clouddk.FirewallRuleCreateBody{
-		Command:  d.Get(dataSourceFirewallRuleCommandKey).(string),
-		Protocol: d.Get(dataSourceFirewallRuleProtocolKey).(string),
-		Address:  address[0],
-		Bits:     clouddk.CustomInt(bits),
-		Port:     d.Get(dataSourceFirewallRulePortKey).(string),
+		return err
 	}
 
 	reqBody := new(bytes.Buffer)
@@ -94,22 +85,14 @@ func resourceFirewallRuleCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err = resourceServerLock(d, m, serverID)
-
-	if err != nil {
-		return err
-	}
+	var res *http.Response
 
-	res, err := clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/network-interfaces/%s/firewall-rules", serverID, networkInterfaceID), reqBody, []int{200}, 60, 10)
-
-	if err != nil {
-		resourceServerUnlock(d, m, serverID)
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	err = resourceServerWithLock(m, serverID, "create firewall rule", func() (err error) {
+		res, err = clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/network-interfaces/%s/firewall-rules", serverID, networkInterfaceID), reqBody, []int{200}, 60, 10)
 
 		return err
-	}
-
-	err = resourceServerUnlock(d, m, serverID)
+	})
 
 	if err != nil {
 		return err
@@ -172,24 +155,15 @@ func resourceFirewallRuleUpdate(d *schema.ResourceData, m interface{}) error {
 
 	firewallRuleID := d.Id()
 	networkInterfaceID := d.Get(dataSourceFirewallRuleNetworkInterfaceIDKey).(string)
-	address := strings.Split(d.Get(dataSourceFirewallRuleAddressKey).(string), "/")
-
-	if len(address) != 2 {
-		return fmt.Errorf("Invalid address '%s' for firewall rule (must be defined as x.x.x.x/x)", d.Get(dataSourceFirewallRuleAddressKey).(string))
-	}
-
-	bits, err := strconv.Atoi(address[1])
+	body, err := resourceFirewallRuleBody(
+		d.Get(dataSourceFirewallRuleAddressKey).(string),
+		d.Get(dataSourceFirewallRuleCommandKey).(string),
+		d.Get(dataSourceFirewallRulePortKey).(string),
+		d.Get(dataSourceFirewallRuleProtocolKey).(string),
+	)
 
 	if err != nil {
-		return fmt.Errorf("Invalid address '%s' for firewall rule (%s)", d.Get(dataSourceFirewallRuleAddressKey).(string), err.Error())
-	}
-
-	body := clouddk.FirewallRuleCreateBody{
-		Command:  d.Get(dataSourceFirewallRuleCommandKey).(string),
-		Protocol: d.Get(dataSourceFirewallRuleProtocolKey).(string),
-		Address:  address[0],
-		Bits:     clouddk.CustomInt(bits),
-		Port:     d.Get(dataSourceFirewallRulePortKey).(string),
+		return err
 	}
 
 	reqBody := new(bytes.Buffer)
@@ -199,22 +173,14 @@ func resourceFirewallRuleUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err = resourceServerLock(d, m, serverID)
-
-	if err != nil {
-		return err
-	}
-
-	res, err := clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("cloudservers/%s/network-interfaces/%s/firewall-rules/%s", serverID, networkInterfaceID, firewallRuleID), reqBody, []int{200}, 60, 10)
+	var res *http.Response
 
-	if err != nil {
-		resourceServerUnlock(d, m, serverID)
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	err = resourceServerWithLock(m, serverID, "update firewall rule", func() (err error) {
+		res, err = clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("cloudservers/%s/network-interfaces/%s/firewall-rules/%s", serverID, networkInterfaceID, firewallRuleID), reqBody, []int{200}, 60, 10)
 
 		return err
-	}
-
-	err = resourceServerUnlock(d, m, serverID)
+	})
 
 	if err != nil {
 		return err
@@ -239,27 +205,42 @@ func resourceFirewallRuleDelete(d *schema.ResourceData, m interface{}) error {
 	networkInterfaceID := d.Get(dataSourceFirewallRuleNetworkInterfaceIDKey).(string)
 
 	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err := resourceServerLock(d, m, serverID)
+	err := resourceServerWithLock(m, serverID, "delete firewall rule", func() error {
+		_, err := clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s/network-interfaces/%s/firewall-rules/%s", serverID, networkInterfaceID, firewallRuleID), new(bytes.Buffer), []int{200, 404}, 60, 10)
+
+		return err
+	})
 
 	if err != nil {
 		return err
 	}
 
-	_, err = clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s/network-interfaces/%s/firewall-rules/%s", serverID, networkInterfaceID, firewallRuleID), new(bytes.Buffer), []int{200, 404}, 60, 10)
+	d.SetId("")
 
-	if err != nil {
-		resourceServerUnlock(d, m, serverID)
+	return nil
+}
 
-		return err
+// resourceFirewallRuleBody creates the request body for a firewall rule.
+func resourceFirewallRuleBody(address string, command string, port string, protocol string) (*clouddk.FirewallRuleCreateBody, error) {
+	addressParts := strings.Split(address, "/")
+
+	if len(addressParts) != 2 {
+		return nil, fmt.Errorf("Invalid address '%s' for firewall rule (must be defined as x.x.x.x/x)", address)
 	}
 
-	err = resourceServerUnlock(d, m, serverID)
+	bits, err := strconv.Atoi(addressParts[1])
 
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("Invalid address '%s' for firewall rule (%s)", address, err.Error())
 	}
 
-	d.SetId("")
+	body := clouddk.FirewallRuleCreateBody{
+		Command:  command,
+		Protocol: protocol,
+		Address:  addressParts[0],
+		Bits:     clouddk.CustomInt(bits),
+		Port:     port,
+	}
 
-	return nil
+	return &body, nil
 }
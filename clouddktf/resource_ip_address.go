@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -20,6 +21,7 @@ const (
 	resourceIPAddressNetmaskKey            = "netmask"
 	resourceIPAddressNetworkKey            = "network"
 	resourceIPAddressNetworkInterfaceIDKey = "network_interface_id"
+	resourceIPAddressReverseDNSKey         = "reverse_dns"
 	resourceIPAddressServerIDKey           = "server_id"
 )
 
@@ -49,143 +51,324 @@ func resourceIPAddress() *schema.Resource {
 			},
 			resourceIPAddressNetworkInterfaceIDKey: {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "The network interface id",
 			},
+			resourceIPAddressReverseDNSKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The reverse DNS record (PTR)",
+			},
 			resourceIPAddressServerIDKey: {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "The server identifier",
-				ForceNew:    true,
 			},
 		},
 
 		Create: resourceIPAddressCreate,
 		Read:   resourceIPAddressRead,
+		Update: resourceIPAddressUpdate,
 		Delete: resourceIPAddressDelete,
+
+		CustomizeDiff: resourceIPAddressCustomizeDiff,
 	}
 }
 
+// resourceIPAddressCustomizeDiff marks the network interface as unknown when an address is moved to another server and reserves the account quota for new addresses.
+func resourceIPAddressCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return accountQuotas.Reserve(m, "", map[string]int{accountQuotaIPAddresses: 1})
+	}
+
+	if d.HasChange(resourceIPAddressServerIDKey) && !d.HasChange(resourceIPAddressNetworkInterfaceIDKey) {
+		return d.SetNewComputed(resourceIPAddressNetworkInterfaceIDKey)
+	}
+
+	return nil
+}
+
 // resourceIPAddressCreate creates an IP address.
 func resourceIPAddressCreate(d *schema.ResourceData, m interface{}) error {
 	clientSettings := m.(clouddk.ClientSettings)
 
+	networkInterfaceID := d.Get(resourceIPAddressNetworkInterfaceIDKey).(string)
 	serverID := d.Get(resourceIPAddressServerIDKey).(string)
 
-	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err := resourceServerLock(d, m, serverID)
+	body := clouddk.IPAddressCreateBody{
+		NetworkInterfaceIdentifier: networkInterfaceID,
+	}
+
+	reqBody := new(bytes.Buffer)
+	err := json.NewEncoder(reqBody).Encode(body)
 
 	if err != nil {
 		return err
 	}
 
-	res, err := clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/ip-addresses", serverID), new(bytes.Buffer), []int{200}, 60, 10)
+	var ipAddress *clouddk.IPAddressBody
 
-	if err != nil {
-		resourceServerUnlock(d, m, serverID)
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	err = resourceServerWithLock(m, serverID, "create IP address", func() error {
+		// The API returns the complete list of addresses, which is why we need to compare it to the list prior to the allocation.
+		res, err := clouddk.DoClientRequest(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/ip-addresses", serverID), new(bytes.Buffer), []int{200}, 60, 10)
 
-		return err
-	}
+		if err != nil {
+			return err
+		}
+
+		ipAddressesBefore := clouddk.IPAddressListBody{}
+		err = json.NewDecoder(res.Body).Decode(&ipAddressesBefore)
+
+		if err != nil {
+			return err
+		}
+
+		res, err = clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/ip-addresses", serverID), reqBody, []int{200}, 60, 10)
+
+		if err != nil {
+			return err
+		}
+
+		ipAddressesAfter := clouddk.IPAddressListBody{}
+		err = json.NewDecoder(res.Body).Decode(&ipAddressesAfter)
+
+		if err != nil {
+			return err
+		}
+
+		ipAddress, err = resourceIPAddressFindAllocated(ipAddressesBefore, ipAddressesAfter, networkInterfaceID)
+
+		if err != nil {
+			return err
+		}
+
+		// The identifier must be stored immediately as the address would otherwise be orphaned, if one of the following steps fails.
+		d.SetId(ipAddress.Address)
 
-	err = resourceServerUnlock(d, m, serverID)
+		return nil
+	})
+
+	if ipAddress != nil {
+		accountQuotas.Commit("", map[string]int{accountQuotaIPAddresses: 1})
+	}
 
 	if err != nil {
 		return err
 	}
 
-	ipAddresses := clouddk.IPAddressListBody{}
-	err = json.NewDecoder(res.Body).Decode(&ipAddresses)
+	if v, ok := d.GetOk(resourceIPAddressReverseDNSKey); ok {
+		err = resourceIPAddressSetReverseDNS(m, serverID, ipAddress.Address, v.(string))
 
-	if err != nil {
-		return err
+		if err != nil {
+			return err
+		}
+
+		ipAddress.ReverseDNS = v.(string)
 	}
 
-	d.SetId(ipAddresses[len(ipAddresses)-1].Address)
+	return resourceIPAddressReadResponseBody(d, m, ipAddress)
+}
 
-	d.Set(resourceIPAddressAddressKey, ipAddresses[len(ipAddresses)-1].Address)
-	d.Set(resourceIPAddressGatewayKey, ipAddresses[len(ipAddresses)-1].Gateway)
-	d.Set(resourceIPAddressNetmaskKey, ipAddresses[len(ipAddresses)-1].Netmask)
-	d.Set(resourceIPAddressNetworkKey, ipAddresses[len(ipAddresses)-1].Network)
-	d.Set(resourceIPAddressNetworkInterfaceIDKey, ipAddresses[len(ipAddresses)-1].NetworkInterfaceIdentifier)
+// resourceIPAddressFindAllocated identifies the address which was allocated by comparing the address lists before and after the allocation.
+func resourceIPAddressFindAllocated(before clouddk.IPAddressListBody, after clouddk.IPAddressListBody, networkInterfaceID string) (*clouddk.IPAddressBody, error) {
+	existing := make(map[string]bool, len(before))
 
-	return nil
+	for _, v := range before {
+		existing[v.Address] = true
+	}
+
+	allocated := make(clouddk.IPAddressListBody, 0, 1)
+	addresses := []string{}
+
+	for _, v := range after {
+		if existing[v.Address] {
+			continue
+		}
+
+		addresses = append(addresses, v.Address)
+
+		if len(networkInterfaceID) > 0 && v.NetworkInterfaceIdentifier != networkInterfaceID {
+			continue
+		}
+
+		allocated = append(allocated, v)
+	}
+
+	if len(allocated) == 1 {
+		return &allocated[0], nil
+	} else if len(addresses) == 0 {
+		return nil, fmt.Errorf("Failed to identify the allocated IP address - Reason: The API did not report any new addresses")
+	}
+
+	// The new addresses cannot be released safely as they may have been allocated by another process.
+	return nil, fmt.Errorf("Failed to identify the allocated IP address - Reason: Expected exactly one new address but found %d - The following new addresses are not managed by Terraform and must be released manually, if they are not in use: %s", len(allocated), strings.Join(addresses, ", "))
 }
 
 // resourceIPAddressRead reads information about an existing IP address.
 func resourceIPAddressRead(d *schema.ResourceData, m interface{}) error {
-	clientSettings := m.(clouddk.ClientSettings)
-
 	address := d.Id()
 	serverID := d.Get(resourceIPAddressServerIDKey).(string)
 
-	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/ip-addresses", serverID), new(bytes.Buffer))
+	ipAddress, err := resourceIPAddressGet(m, serverID, address)
 
 	if err != nil {
 		return err
+	} else if ipAddress == nil {
+		d.SetId("")
+
+		return nil
+	}
+
+	return resourceIPAddressReadResponseBody(d, m, ipAddress)
+}
+
+// resourceIPAddressReadResponseBody parses information about an IP address.
+func resourceIPAddressReadResponseBody(d *schema.ResourceData, m interface{}, ipAddress *clouddk.IPAddressBody) error {
+	d.Set(resourceIPAddressAddressKey, ipAddress.Address)
+	d.Set(resourceIPAddressGatewayKey, ipAddress.Gateway)
+	d.Set(resourceIPAddressNetmaskKey, ipAddress.Netmask)
+	d.Set(resourceIPAddressNetworkKey, ipAddress.Network)
+	d.Set(resourceIPAddressNetworkInterfaceIDKey, ipAddress.NetworkInterfaceIdentifier)
+	d.Set(resourceIPAddressReverseDNSKey, ipAddress.ReverseDNS)
+
+	return nil
+}
+
+// resourceIPAddressGet retrieves an IP address assigned to a server and returns nil, if the address does not exist.
+func resourceIPAddressGet(m interface{}, serverID string, address string) (*clouddk.IPAddressBody, error) {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/ip-addresses", serverID), new(bytes.Buffer))
+
+	if err != nil {
+		return nil, err
 	}
 
 	client := &http.Client{}
 	res, err := client.Do(req)
 
 	if err != nil {
-		return err
+		return nil, err
 	} else if res.StatusCode != 200 {
 		if res.StatusCode == 404 {
-			d.SetId("")
-
-			return nil
+			return nil, nil
 		}
 
-		return fmt.Errorf("Failed to read the IP address information - Reason: The API responded with HTTP %s", res.Status)
+		return nil, fmt.Errorf("Failed to read the IP address information - Reason: The API responded with HTTP %s", res.Status)
 	}
 
 	ipAddresses := clouddk.IPAddressListBody{}
 	err = json.NewDecoder(res.Body).Decode(&ipAddresses)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, v := range ipAddresses {
+	for i, v := range ipAddresses {
 		if v.Address == address {
-			d.Set(resourceIPAddressAddressKey, v.Address)
-			d.Set(resourceIPAddressGatewayKey, v.Gateway)
-			d.Set(resourceIPAddressNetmaskKey, v.Netmask)
-			d.Set(resourceIPAddressNetworkKey, v.Network)
-			d.Set(resourceIPAddressNetworkInterfaceIDKey, v.NetworkInterfaceIdentifier)
+			return &ipAddresses[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// resourceIPAddressUpdate updates an existing IP address.
+func resourceIPAddressUpdate(d *schema.ResourceData, m interface{}) error {
+	address := d.Id()
+	serverID := d.Get(resourceIPAddressServerIDKey).(string)
 
-			return nil
+	if d.HasChange(resourceIPAddressServerIDKey) || d.HasChange(resourceIPAddressNetworkInterfaceIDKey) {
+		oldServerID, _ := d.GetChange(resourceIPAddressServerIDKey)
+		networkInterfaceID := ""
+
+		if d.HasChange(resourceIPAddressNetworkInterfaceIDKey) {
+			networkInterfaceID = d.Get(resourceIPAddressNetworkInterfaceIDKey).(string)
+		}
+
+		err := resourceIPAddressMove(m, oldServerID.(string), serverID, networkInterfaceID, address)
+
+		if err != nil {
+			return err
 		}
 	}
 
-	d.SetId("")
+	if d.HasChange(resourceIPAddressReverseDNSKey) {
+		err := resourceIPAddressSetReverseDNS(m, serverID, address, d.Get(resourceIPAddressReverseDNSKey).(string))
 
-	return nil
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIPAddressRead(d, m)
 }
 
-// resourceIPAddressDelete deletes an existing IP address.
-func resourceIPAddressDelete(d *schema.ResourceData, m interface{}) error {
+// resourceIPAddressMove reassigns an IP address to another server or network interface without releasing it.
+func resourceIPAddressMove(m interface{}, sourceServerID string, targetServerID string, targetNetworkInterfaceID string, address string) error {
 	clientSettings := m.(clouddk.ClientSettings)
 
-	serverID := d.Get(resourceIPAddressServerIDKey).(string)
-	address := d.Id()
+	body := clouddk.IPAddressMoveBody{
+		Address:                          address,
+		TargetServerIdentifier:           targetServerID,
+		TargetNetworkInterfaceIdentifier: targetNetworkInterfaceID,
+	}
 
-	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err := resourceServerLock(d, m, serverID)
+	reqBody := new(bytes.Buffer)
+	err := json.NewEncoder(reqBody).Encode(body)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s/ip-addresses?address=%s", serverID, address), new(bytes.Buffer), []int{200, 404}, 60, 10)
+	// We need to acquire the locks for both servers to reduce the risk of race conditions.
+	return resourceServerWithLocks(m, []string{sourceServerID, targetServerID}, "move IP address", func() error {
+		_, err := clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/ip-addresses/move", sourceServerID), reqBody, []int{200}, 60, 10)
 
-	if err != nil {
-		resourceServerUnlock(d, m, serverID)
+		return err
+	})
+}
+
+// resourceIPAddressSetReverseDNS sets the reverse DNS record (PTR) for an IP address.
+func resourceIPAddressSetReverseDNS(m interface{}, serverID string, address string, reverseDNS string) error {
+	clientSettings := m.(clouddk.ClientSettings)
 
+	body := clouddk.IPAddressReverseDNSBody{
+		Address:    address,
+		ReverseDNS: reverseDNS,
+	}
+
+	reqBody := new(bytes.Buffer)
+	err := json.NewEncoder(reqBody).Encode(body)
+
+	if err != nil {
 		return err
 	}
 
-	err = resourceServerUnlock(d, m, serverID)
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	return resourceServerWithLock(m, serverID, "update reverse DNS", func() error {
+		_, err := clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("cloudservers/%s/ip-addresses/reverse-dns", serverID), reqBody, []int{200}, 60, 10)
+
+		return err
+	})
+}
+
+// resourceIPAddressDelete deletes an existing IP address.
+func resourceIPAddressDelete(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	serverID := d.Get(resourceIPAddressServerIDKey).(string)
+	address := d.Id()
+
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	err := resourceServerWithLock(m, serverID, "delete IP address", func() error {
+		_, err := clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s/ip-addresses?address=%s", serverID, address), new(bytes.Buffer), []int{200, 404}, 60, 10)
+
+		return err
+	})
 
 	if err != nil {
 		return err
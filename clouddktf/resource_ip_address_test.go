@@ -5,7 +5,10 @@
 package clouddktf
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
 )
 
 // TestResourceIPAddressInstantiation tests whether the resourceIPAddress instance can be instantiated.
@@ -35,12 +38,17 @@ func TestResourceIPAddressSchema(t *testing.T) {
 		}
 	}
 
+	if s.Schema[resourceIPAddressNetworkInterfaceIDKey].Optional != true {
+		t.Fatalf("Error in resourceIPAddress.Schema: Argument \"%s\" is not optional", resourceIPAddressNetworkInterfaceIDKey)
+	}
+
 	attributeKeys := []string{
 		resourceIPAddressAddressKey,
 		resourceIPAddressGatewayKey,
 		resourceIPAddressNetmaskKey,
 		resourceIPAddressNetworkKey,
 		resourceIPAddressNetworkInterfaceIDKey,
+		resourceIPAddressReverseDNSKey,
 	}
 
 	for _, v := range attributeKeys {
@@ -53,3 +61,48 @@ func TestResourceIPAddressSchema(t *testing.T) {
 		}
 	}
 }
+
+// TestResourceIPAddressFindAllocated tests the resourceIPAddressFindAllocated function.
+func TestResourceIPAddressFindAllocated(t *testing.T) {
+	before := clouddk.IPAddressListBody{
+		{Address: "192.0.2.10", NetworkInterfaceIdentifier: "a"},
+	}
+	after := clouddk.IPAddressListBody{
+		{Address: "192.0.2.11", NetworkInterfaceIdentifier: "b"},
+		{Address: "192.0.2.10", NetworkInterfaceIdentifier: "a"},
+	}
+
+	ipAddress, err := resourceIPAddressFindAllocated(before, after, "")
+
+	if err != nil {
+		t.Fatalf("Error in resourceIPAddressFindAllocated: %s", err.Error())
+	} else if ipAddress.Address != "192.0.2.11" {
+		t.Fatalf("Error in resourceIPAddressFindAllocated: Expected address \"192.0.2.11\" but got \"%s\"", ipAddress.Address)
+	}
+
+	_, err = resourceIPAddressFindAllocated(before, after, "a")
+
+	if err == nil {
+		t.Fatalf("Error in resourceIPAddressFindAllocated: An address for another network interface is accepted")
+	}
+
+	after = append(after, clouddk.IPAddressBody{Address: "192.0.2.12", NetworkInterfaceIdentifier: "b"})
+
+	_, err = resourceIPAddressFindAllocated(before, after, "b")
+
+	if err == nil {
+		t.Fatalf("Error in resourceIPAddressFindAllocated: Multiple new addresses are accepted")
+	}
+
+	for _, v := range []string{"192.0.2.11", "192.0.2.12"} {
+		if !strings.Contains(err.Error(), v) {
+			t.Fatalf("Error in resourceIPAddressFindAllocated: The error does not name the new address \"%s\": %s", v, err.Error())
+		}
+	}
+
+	_, err = resourceIPAddressFindAllocated(before, before, "")
+
+	if err == nil {
+		t.Fatalf("Error in resourceIPAddressFindAllocated: A missing address is accepted")
+	}
+}
@@ -0,0 +1,195 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	resourcePrivateNetworkCIDRKey       = "cidr"
+	resourcePrivateNetworkLabelKey      = "label"
+	resourcePrivateNetworkLocationIDKey = "location_id"
+)
+
+// resourcePrivateNetwork manages a private network.
+func resourcePrivateNetwork() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			resourcePrivateNetworkCIDRKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CIDR block for the private network",
+				ForceNew:    true,
+			},
+			resourcePrivateNetworkLabelKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The private network label",
+			},
+			resourcePrivateNetworkLocationIDKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The location identifier",
+				ForceNew:    true,
+			},
+		},
+
+		Create: resourcePrivateNetworkCreate,
+		Read:   resourcePrivateNetworkRead,
+		Update: resourcePrivateNetworkUpdate,
+		Delete: resourcePrivateNetworkDelete,
+	}
+}
+
+// resourcePrivateNetworkCreate creates a private network.
+func resourcePrivateNetworkCreate(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	body := clouddk.PrivateNetworkCreateBody{
+		Label:    d.Get(resourcePrivateNetworkLabelKey).(string),
+		CIDR:     d.Get(resourcePrivateNetworkCIDRKey).(string),
+		Location: d.Get(resourcePrivateNetworkLocationIDKey).(string),
+	}
+
+	_, _, err := net.ParseCIDR(body.CIDR)
+
+	if err != nil {
+		return fmt.Errorf("Invalid CIDR block '%s' - Reason: %s", body.CIDR, err.Error())
+	}
+
+	reqBody := new(bytes.Buffer)
+	err = json.NewEncoder(reqBody).Encode(body)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := clouddk.DoClientRequest(&clientSettings, "POST", "private-networks", reqBody, []int{200}, 60, 10)
+
+	if err != nil {
+		return err
+	}
+
+	privateNetwork := clouddk.PrivateNetworkBody{}
+	err = json.NewDecoder(res.Body).Decode(&privateNetwork)
+
+	if err != nil {
+		return err
+	}
+
+	return resourcePrivateNetworkReadResponseBody(d, m, &privateNetwork)
+}
+
+// resourcePrivateNetworkRead reads information about an existing private network.
+func resourcePrivateNetworkRead(d *schema.ResourceData, m interface{}) error {
+	privateNetwork, err := resourcePrivateNetworkGet(m, d.Id())
+
+	if err != nil {
+		return err
+	} else if privateNetwork == nil {
+		d.SetId("")
+
+		return nil
+	}
+
+	return resourcePrivateNetworkReadResponseBody(d, m, privateNetwork)
+}
+
+// resourcePrivateNetworkReadResponseBody parses information about a private network.
+func resourcePrivateNetworkReadResponseBody(d *schema.ResourceData, m interface{}, privateNetwork *clouddk.PrivateNetworkBody) error {
+	d.SetId(privateNetwork.Identifier)
+
+	d.Set(resourcePrivateNetworkCIDRKey, privateNetwork.CIDR)
+	d.Set(resourcePrivateNetworkLabelKey, privateNetwork.Label)
+	d.Set(resourcePrivateNetworkLocationIDKey, privateNetwork.Location.Identifier)
+
+	return nil
+}
+
+// resourcePrivateNetworkUpdate updates an existing private network.
+func resourcePrivateNetworkUpdate(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	body := clouddk.PrivateNetworkUpdateBody{
+		Label: d.Get(resourcePrivateNetworkLabelKey).(string),
+	}
+
+	reqBody := new(bytes.Buffer)
+	err := json.NewEncoder(reqBody).Encode(body)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("private-networks/%s", d.Id()), reqBody, []int{200}, 60, 10)
+
+	if err != nil {
+		return err
+	}
+
+	privateNetwork := clouddk.PrivateNetworkBody{}
+	err = json.NewDecoder(res.Body).Decode(&privateNetwork)
+
+	if err != nil {
+		return err
+	}
+
+	return resourcePrivateNetworkReadResponseBody(d, m, &privateNetwork)
+}
+
+// resourcePrivateNetworkDelete deletes an existing private network.
+func resourcePrivateNetworkDelete(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	_, err := clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("private-networks/%s", d.Id()), new(bytes.Buffer), []int{200, 404}, 60, 10)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourcePrivateNetworkGet retrieves a private network and returns nil, if the network does not exist.
+func resourcePrivateNetworkGet(m interface{}, privateNetworkID string) (*clouddk.PrivateNetworkBody, error) {
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("private-networks/%s", privateNetworkID), new(bytes.Buffer))
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode != 200 {
+		if res.StatusCode == 404 {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Failed to read the private network information - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	privateNetwork := clouddk.PrivateNetworkBody{}
+	err = json.NewDecoder(res.Body).Decode(&privateNetwork)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &privateNetwork, nil
+}
@@ -0,0 +1,328 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	resourcePrivateNetworkAttachmentIPAddressKey          = "ip_address"
+	resourcePrivateNetworkAttachmentLabelKey              = "label"
+	resourcePrivateNetworkAttachmentNetworkInterfaceIDKey = "network_interface_id"
+	resourcePrivateNetworkAttachmentPrivateNetworkIDKey   = "private_network_id"
+	resourcePrivateNetworkAttachmentRateLimitKey          = "rate_limit"
+	resourcePrivateNetworkAttachmentServerIDKey           = "server_id"
+)
+
+// resourcePrivateNetworkAttachment manages the attachment of a server to a private network.
+func resourcePrivateNetworkAttachment() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			resourcePrivateNetworkAttachmentIPAddressKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The private IP address for the server",
+				ForceNew:    true,
+			},
+			resourcePrivateNetworkAttachmentLabelKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The network interface label (defaults to the label assigned by the API)",
+			},
+			resourcePrivateNetworkAttachmentNetworkInterfaceIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network interface identifier",
+			},
+			resourcePrivateNetworkAttachmentPrivateNetworkIDKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The private network identifier",
+				ForceNew:    true,
+			},
+			resourcePrivateNetworkAttachmentRateLimitKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The rate limit for the network interface",
+			},
+			resourcePrivateNetworkAttachmentServerIDKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The server identifier",
+				ForceNew:    true,
+			},
+		},
+
+		CustomizeDiff: resourcePrivateNetworkAttachmentCustomizeDiff,
+
+		Create: resourcePrivateNetworkAttachmentCreate,
+		Read:   resourcePrivateNetworkAttachmentRead,
+		Update: resourcePrivateNetworkAttachmentUpdate,
+		Delete: resourcePrivateNetworkAttachmentDelete,
+	}
+}
+
+// resourcePrivateNetworkAttachmentCustomizeDiff validates the rate limit during the plan phase.
+func resourcePrivateNetworkAttachmentCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	return resourceServerNetworkInterfaceRateLimitCustomizeDiff(d, resourcePrivateNetworkAttachmentRateLimitKey)
+}
+
+// resourcePrivateNetworkAttachmentCreate attaches a server to a private network.
+func resourcePrivateNetworkAttachmentCreate(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	privateNetworkID := d.Get(resourcePrivateNetworkAttachmentPrivateNetworkIDKey).(string)
+	serverID := d.Get(resourcePrivateNetworkAttachmentServerIDKey).(string)
+
+	body := clouddk.NetworkInterfaceCreateBody{
+		Label:          d.Get(resourcePrivateNetworkAttachmentLabelKey).(string),
+		PrivateNetwork: privateNetworkID,
+		IPAddress:      d.Get(resourcePrivateNetworkAttachmentIPAddressKey).(string),
+	}
+
+	privateNetwork, err := resourcePrivateNetworkGet(m, privateNetworkID)
+
+	if err != nil {
+		return err
+	} else if privateNetwork == nil {
+		return fmt.Errorf("Failed to attach server (id: %s) to private network - Reason: The private network (id: %s) does not exist", serverID, privateNetworkID)
+	}
+
+	server, err := resourceServerGet(m, serverID)
+
+	if err != nil {
+		return err
+	}
+
+	err = resourcePrivateNetworkAttachmentValidate(privateNetwork, server, body.IPAddress)
+
+	if err != nil {
+		return err
+	}
+
+	reqBody := new(bytes.Buffer)
+	err = json.NewEncoder(reqBody).Encode(body)
+
+	if err != nil {
+		return err
+	}
+
+	var res *http.Response
+
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	err = resourceServerWithLock(m, serverID, "attach private network", func() (err error) {
+		res, err = clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/network-interfaces", serverID), reqBody, []int{200}, 60, 10)
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	networkInterface := clouddk.NetworkInterfaceBody{}
+	err = json.NewDecoder(res.Body).Decode(&networkInterface)
+
+	if err != nil {
+		return err
+	}
+
+	// The rate limit cannot be specified when creating the network interface, which is why we need to update it afterwards.
+	if _, ok := d.GetOkExists(resourcePrivateNetworkAttachmentRateLimitKey); ok {
+		d.SetId(networkInterface.Identifier)
+
+		return resourcePrivateNetworkAttachmentUpdate(d, m)
+	}
+
+	return resourcePrivateNetworkAttachmentReadResponseBody(d, m, &networkInterface)
+}
+
+// resourcePrivateNetworkAttachmentRead reads information about an existing private network attachment.
+func resourcePrivateNetworkAttachmentRead(d *schema.ResourceData, m interface{}) error {
+	networkInterface, err := resourcePrivateNetworkAttachmentGet(m, d.Get(resourcePrivateNetworkAttachmentServerIDKey).(string), d.Id())
+
+	if err != nil {
+		return err
+	} else if networkInterface == nil {
+		d.SetId("")
+
+		return nil
+	}
+
+	return resourcePrivateNetworkAttachmentReadResponseBody(d, m, networkInterface)
+}
+
+// resourcePrivateNetworkAttachmentReadResponseBody parses information about a private network attachment.
+func resourcePrivateNetworkAttachmentReadResponseBody(d *schema.ResourceData, m interface{}, networkInterface *clouddk.NetworkInterfaceBody) error {
+	d.SetId(networkInterface.Identifier)
+
+	if len(networkInterface.IPAddresses) > 0 {
+		d.Set(resourcePrivateNetworkAttachmentIPAddressKey, networkInterface.IPAddresses[0].Address)
+	} else {
+		d.Set(resourcePrivateNetworkAttachmentIPAddressKey, "")
+	}
+
+	d.Set(resourcePrivateNetworkAttachmentLabelKey, networkInterface.Label)
+	d.Set(resourcePrivateNetworkAttachmentNetworkInterfaceIDKey, networkInterface.Identifier)
+	d.Set(resourcePrivateNetworkAttachmentPrivateNetworkIDKey, networkInterface.PrivateNetwork)
+	d.Set(resourcePrivateNetworkAttachmentRateLimitKey, int(networkInterface.RateLimit))
+
+	return nil
+}
+
+// resourcePrivateNetworkAttachmentUpdate updates an existing private network attachment.
+func resourcePrivateNetworkAttachmentUpdate(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	networkInterfaceID := d.Id()
+	serverID := d.Get(resourcePrivateNetworkAttachmentServerIDKey).(string)
+
+	networkInterface, err := resourcePrivateNetworkAttachmentGet(m, serverID, networkInterfaceID)
+
+	if err != nil {
+		return err
+	} else if networkInterface == nil {
+		return fmt.Errorf("Failed to update the private network attachment - Reason: The network interface (id: %s) no longer exists", networkInterfaceID)
+	}
+
+	rateLimit, err := resourceServerNetworkInterfaceRateLimit(d, resourcePrivateNetworkAttachmentRateLimitKey, networkInterface)
+
+	if err != nil {
+		return err
+	}
+
+	body := clouddk.NetworkInterfaceUpdateBody{
+		DefaultFirewallRule: networkInterface.DefaultFirewallRule,
+		Label:               d.Get(resourcePrivateNetworkAttachmentLabelKey).(string),
+		RateLimit:           rateLimit,
+	}
+
+	reqBody := new(bytes.Buffer)
+	err = json.NewEncoder(reqBody).Encode(body)
+
+	if err != nil {
+		return err
+	}
+
+	var res *http.Response
+
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	err = resourceServerWithLock(m, serverID, "update private network attachment", func() (err error) {
+		res, err = clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("cloudservers/%s/network-interfaces/%s", serverID, networkInterfaceID), reqBody, []int{200}, 60, 10)
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	err = json.NewDecoder(res.Body).Decode(networkInterface)
+
+	if err != nil {
+		return err
+	}
+
+	return resourcePrivateNetworkAttachmentReadResponseBody(d, m, networkInterface)
+}
+
+// resourcePrivateNetworkAttachmentDelete detaches a server from a private network.
+func resourcePrivateNetworkAttachmentDelete(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	networkInterfaceID := d.Id()
+	serverID := d.Get(resourcePrivateNetworkAttachmentServerIDKey).(string)
+
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	err := resourceServerWithLock(m, serverID, "detach private network", func() error {
+		_, err := clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s/network-interfaces/%s", serverID, networkInterfaceID), new(bytes.Buffer), []int{200, 404}, 60, 10)
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourcePrivateNetworkAttachmentGet retrieves a network interface and returns nil, if the interface does not exist.
+func resourcePrivateNetworkAttachmentGet(m interface{}, serverID string, networkInterfaceID string) (*clouddk.NetworkInterfaceBody, error) {
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/network-interfaces/%s", serverID, networkInterfaceID), new(bytes.Buffer))
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode != 200 {
+		if res.StatusCode == 404 {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Failed to read the network interface information - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	networkInterface := clouddk.NetworkInterfaceBody{}
+	err = json.NewDecoder(res.Body).Decode(&networkInterface)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &networkInterface, nil
+}
+
+// resourcePrivateNetworkAttachmentValidate verifies that a server can be attached to a private network with the given IP address.
+func resourcePrivateNetworkAttachmentValidate(privateNetwork *clouddk.PrivateNetworkBody, server *clouddk.ServerBody, ipAddress string) error {
+	if server.Location.Identifier != privateNetwork.Location.Identifier {
+		return fmt.Errorf("Failed to attach server (id: %s) to private network (id: %s) - Reason: The server is located in '%s' while the network is located in '%s'", server.Identifier, privateNetwork.Identifier, server.Location.Identifier, privateNetwork.Location.Identifier)
+	}
+
+	for _, v := range server.NetworkInterfaces {
+		if v.PrivateNetwork == privateNetwork.Identifier {
+			return fmt.Errorf("Failed to attach server (id: %s) to private network (id: %s) - Reason: The server is already attached to the network (network interface id: %s)", server.Identifier, privateNetwork.Identifier, v.Identifier)
+		}
+	}
+
+	if len(ipAddress) == 0 {
+		return nil
+	}
+
+	_, cidr, err := net.ParseCIDR(privateNetwork.CIDR)
+
+	if err != nil {
+		return fmt.Errorf("Invalid CIDR block '%s' for private network (id: %s) - Reason: %s", privateNetwork.CIDR, privateNetwork.Identifier, err.Error())
+	}
+
+	ip := net.ParseIP(ipAddress)
+
+	if ip == nil {
+		return fmt.Errorf("Invalid IP address '%s'", ipAddress)
+	} else if !cidr.Contains(ip) {
+		return fmt.Errorf("Invalid IP address '%s' - Reason: The address is not within the private network's CIDR block (%s)", ipAddress, privateNetwork.CIDR)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// TestResourcePrivateNetworkAttachmentInstantiation tests whether the resourcePrivateNetworkAttachment instance can be instantiated.
+func TestResourcePrivateNetworkAttachmentInstantiation(t *testing.T) {
+	s := resourcePrivateNetworkAttachment()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourcePrivateNetworkAttachment")
+	}
+}
+
+// TestResourcePrivateNetworkAttachmentSchema tests the resourcePrivateNetworkAttachment schema.
+func TestResourcePrivateNetworkAttachmentSchema(t *testing.T) {
+	s := resourcePrivateNetworkAttachment()
+
+	requiredKeys := []string{
+		resourcePrivateNetworkAttachmentPrivateNetworkIDKey,
+		resourcePrivateNetworkAttachmentServerIDKey,
+	}
+
+	for _, v := range requiredKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourcePrivateNetworkAttachment.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Required != true {
+			t.Fatalf("Error in resourcePrivateNetworkAttachment.Schema: Argument \"%s\" is not required", v)
+		}
+	}
+
+	optionalKeys := []string{
+		resourcePrivateNetworkAttachmentIPAddressKey,
+		resourcePrivateNetworkAttachmentLabelKey,
+		resourcePrivateNetworkAttachmentRateLimitKey,
+	}
+
+	for _, v := range optionalKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourcePrivateNetworkAttachment.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Optional != true {
+			t.Fatalf("Error in resourcePrivateNetworkAttachment.Schema: Argument \"%s\" is not optional", v)
+		}
+	}
+
+	attributeKeys := []string{
+		resourcePrivateNetworkAttachmentLabelKey,
+		resourcePrivateNetworkAttachmentNetworkInterfaceIDKey,
+	}
+
+	for _, v := range attributeKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourcePrivateNetworkAttachment.Schema: Missing attribute \"%s\"", v)
+		}
+
+		if s.Schema[v].Computed != true {
+			t.Fatalf("Error in resourcePrivateNetworkAttachment.Schema: Attribute \"%s\" is not computed", v)
+		}
+	}
+}
+
+// TestResourcePrivateNetworkAttachmentValidate tests the resourcePrivateNetworkAttachmentValidate function.
+func TestResourcePrivateNetworkAttachmentValidate(t *testing.T) {
+	privateNetwork := clouddk.PrivateNetworkBody{
+		Identifier: "network",
+		CIDR:       "10.0.0.0/24",
+		Location:   clouddk.LocationBody{Identifier: "dk1"},
+	}
+
+	server := clouddk.ServerBody{
+		Identifier: "server",
+		Location:   clouddk.LocationBody{Identifier: "dk1"},
+	}
+
+	for _, v := range []string{"", "10.0.0.10"} {
+		if err := resourcePrivateNetworkAttachmentValidate(&privateNetwork, &server, v); err != nil {
+			t.Fatalf("Error in resourcePrivateNetworkAttachmentValidate: %s", err.Error())
+		}
+	}
+
+	for _, v := range []string{"10.0.1.10", "invalid"} {
+		if resourcePrivateNetworkAttachmentValidate(&privateNetwork, &server, v) == nil {
+			t.Fatalf("Error in resourcePrivateNetworkAttachmentValidate: Invalid IP address \"%s\" is accepted", v)
+		}
+	}
+
+	attachedServer := server
+	attachedServer.NetworkInterfaces = clouddk.NetworkInterfaceListBody{{Identifier: "interface", PrivateNetwork: "network"}}
+
+	if resourcePrivateNetworkAttachmentValidate(&privateNetwork, &attachedServer, "") == nil {
+		t.Fatalf("Error in resourcePrivateNetworkAttachmentValidate: Server attached twice is accepted")
+	}
+
+	remoteServer := server
+	remoteServer.Location = clouddk.LocationBody{Identifier: "uk1"}
+
+	if resourcePrivateNetworkAttachmentValidate(&privateNetwork, &remoteServer, "") == nil {
+		t.Fatalf("Error in resourcePrivateNetworkAttachmentValidate: Server in another location is accepted")
+	}
+}
+
+// TestResourcePrivateNetworkAttachmentCustomizeDiff tests whether negative rate limits are rejected during the plan phase.
+func TestResourcePrivateNetworkAttachmentCustomizeDiff(t *testing.T) {
+	r := resourcePrivateNetworkAttachment()
+
+	tests := []struct {
+		rateLimit int
+		valid     bool
+	}{
+		{0, true},
+		{100, true},
+		{-1, false},
+	}
+
+	for _, v := range tests {
+		config := map[string]interface{}{
+			resourcePrivateNetworkAttachmentPrivateNetworkIDKey: "network",
+			resourcePrivateNetworkAttachmentRateLimitKey:        v.rateLimit,
+			resourcePrivateNetworkAttachmentServerIDKey:         "server",
+		}
+
+		_, err := r.Diff(nil, terraform.NewResourceConfigRaw(config), nil)
+
+		if v.valid && err != nil {
+			t.Fatalf("Error in resourcePrivateNetworkAttachmentCustomizeDiff: Rate limit %d is rejected - Reason: %s", v.rateLimit, err.Error())
+		} else if !v.valid && err == nil {
+			t.Fatalf("Error in resourcePrivateNetworkAttachmentCustomizeDiff: Invalid rate limit %d is accepted", v.rateLimit)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+)
+
+// TestResourcePrivateNetworkInstantiation tests whether the resourcePrivateNetwork instance can be instantiated.
+func TestResourcePrivateNetworkInstantiation(t *testing.T) {
+	s := resourcePrivateNetwork()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourcePrivateNetwork")
+	}
+}
+
+// TestResourcePrivateNetworkSchema tests the resourcePrivateNetwork schema.
+func TestResourcePrivateNetworkSchema(t *testing.T) {
+	s := resourcePrivateNetwork()
+
+	requiredKeys := []string{
+		resourcePrivateNetworkCIDRKey,
+		resourcePrivateNetworkLabelKey,
+		resourcePrivateNetworkLocationIDKey,
+	}
+
+	for _, v := range requiredKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourcePrivateNetwork.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Required != true {
+			t.Fatalf("Error in resourcePrivateNetwork.Schema: Argument \"%s\" is not required", v)
+		}
+	}
+}
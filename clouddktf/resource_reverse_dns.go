@@ -0,0 +1,159 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"fmt"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	resourceReverseDNSAddressKey    = "address"
+	resourceReverseDNSReverseDNSKey = "reverse_dns"
+	resourceReverseDNSServerIDKey   = "server_id"
+)
+
+// resourceReverseDNS manages the reverse DNS record (PTR) for an IP address assigned to a server.
+func resourceReverseDNS() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			resourceReverseDNSAddressKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The IP address (defaults to the server's primary address)",
+				ForceNew:    true,
+			},
+			resourceReverseDNSReverseDNSKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The reverse DNS record (defaults to the server's hostname)",
+			},
+			resourceReverseDNSServerIDKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The server identifier",
+				ForceNew:    true,
+			},
+		},
+
+		Create: resourceReverseDNSCreate,
+		Read:   resourceReverseDNSRead,
+		Update: resourceReverseDNSUpdate,
+		Delete: resourceReverseDNSDelete,
+	}
+}
+
+// resourceReverseDNSCreate creates the reverse DNS record for an IP address.
+func resourceReverseDNSCreate(d *schema.ResourceData, m interface{}) error {
+	serverID := d.Get(resourceReverseDNSServerIDKey).(string)
+
+	address := d.Get(resourceReverseDNSAddressKey).(string)
+	reverseDNS := d.Get(resourceReverseDNSReverseDNSKey).(string)
+
+	if len(address) == 0 || len(reverseDNS) == 0 {
+		server, err := resourceServerGet(m, serverID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(address) == 0 {
+			address, err = resourceReverseDNSPrimaryAddress(server)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(reverseDNS) == 0 {
+			reverseDNS = server.Hostname
+		}
+	}
+
+	err := resourceIPAddressSetReverseDNS(m, serverID, address, reverseDNS)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(address)
+
+	return resourceReverseDNSRead(d, m)
+}
+
+// resourceReverseDNSRead reads the reverse DNS record for an IP address.
+func resourceReverseDNSRead(d *schema.ResourceData, m interface{}) error {
+	address := d.Id()
+	serverID := d.Get(resourceReverseDNSServerIDKey).(string)
+
+	ipAddress, err := resourceIPAddressGet(m, serverID, address)
+
+	if err != nil {
+		return err
+	} else if ipAddress == nil {
+		d.SetId("")
+
+		return nil
+	}
+
+	d.Set(resourceReverseDNSAddressKey, ipAddress.Address)
+	d.Set(resourceReverseDNSReverseDNSKey, ipAddress.ReverseDNS)
+
+	return nil
+}
+
+// resourceReverseDNSUpdate updates the reverse DNS record for an IP address.
+func resourceReverseDNSUpdate(d *schema.ResourceData, m interface{}) error {
+	address := d.Id()
+	serverID := d.Get(resourceReverseDNSServerIDKey).(string)
+
+	err := resourceIPAddressSetReverseDNS(m, serverID, address, d.Get(resourceReverseDNSReverseDNSKey).(string))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceReverseDNSRead(d, m)
+}
+
+// resourceReverseDNSDelete resets the reverse DNS record for an IP address.
+func resourceReverseDNSDelete(d *schema.ResourceData, m interface{}) error {
+	address := d.Id()
+	serverID := d.Get(resourceReverseDNSServerIDKey).(string)
+
+	ipAddress, err := resourceIPAddressGet(m, serverID, address)
+
+	if err != nil {
+		return err
+	}
+
+	// The record is removed together with the address, which is why there is nothing to reset if the address no longer exists.
+	if ipAddress != nil {
+		err = resourceIPAddressSetReverseDNS(m, serverID, address, "")
+
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceReverseDNSPrimaryAddress returns the first IP address assigned to the primary network interface of a server.
+func resourceReverseDNSPrimaryAddress(server *clouddk.ServerBody) (string, error) {
+	for _, v := range server.NetworkInterfaces {
+		if bool(v.Primary) && len(v.IPAddresses) > 0 {
+			return v.IPAddresses[0].Address, nil
+		}
+	}
+
+	return "", fmt.Errorf("Failed to determine the primary IP address for server (id: %s)", server.Identifier)
+}
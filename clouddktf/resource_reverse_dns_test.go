@@ -0,0 +1,72 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+)
+
+// TestResourceReverseDNSInstantiation tests whether the resourceReverseDNS instance can be instantiated.
+func TestResourceReverseDNSInstantiation(t *testing.T) {
+	s := resourceReverseDNS()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceReverseDNS")
+	}
+}
+
+// TestResourceReverseDNSSchema tests the resourceReverseDNS schema.
+func TestResourceReverseDNSSchema(t *testing.T) {
+	s := resourceReverseDNS()
+
+	if s.Schema[resourceReverseDNSServerIDKey] == nil {
+		t.Fatalf("Error in resourceReverseDNS.Schema: Missing argument \"%s\"", resourceReverseDNSServerIDKey)
+	}
+
+	if s.Schema[resourceReverseDNSServerIDKey].Required != true {
+		t.Fatalf("Error in resourceReverseDNS.Schema: Argument \"%s\" is not required", resourceReverseDNSServerIDKey)
+	}
+
+	optionalKeys := []string{
+		resourceReverseDNSAddressKey,
+		resourceReverseDNSReverseDNSKey,
+	}
+
+	for _, v := range optionalKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourceReverseDNS.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Optional != true || s.Schema[v].Computed != true {
+			t.Fatalf("Error in resourceReverseDNS.Schema: Argument \"%s\" is not optional and computed", v)
+		}
+	}
+}
+
+// TestResourceReverseDNSPrimaryAddress tests the resourceReverseDNSPrimaryAddress function.
+func TestResourceReverseDNSPrimaryAddress(t *testing.T) {
+	server := clouddk.ServerBody{
+		NetworkInterfaces: clouddk.NetworkInterfaceListBody{
+			{Primary: false, IPAddresses: clouddk.IPAddressListBody{{Address: "192.0.2.20"}}},
+			{Primary: true, IPAddresses: clouddk.IPAddressListBody{{Address: "192.0.2.10"}, {Address: "192.0.2.11"}}},
+		},
+	}
+
+	address, err := resourceReverseDNSPrimaryAddress(&server)
+
+	if err != nil {
+		t.Fatalf("Error in resourceReverseDNSPrimaryAddress: %s", err.Error())
+	} else if address != "192.0.2.10" {
+		t.Fatalf("Error in resourceReverseDNSPrimaryAddress: Expected address \"192.0.2.10\" but got \"%s\"", address)
+	}
+
+	_, err = resourceReverseDNSPrimaryAddress(&clouddk.ServerBody{})
+
+	if err == nil {
+		t.Fatalf("Error in resourceReverseDNSPrimaryAddress: A server without addresses is accepted")
+	}
+}
@@ -6,37 +6,77 @@ package clouddktf
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
-	"log"
+	"math/big"
+	"net"
 	"net/http"
-	"sync"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/danitso/terraform-provider-clouddk/clouddk"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"golang.org/x/crypto/ssh"
 )
 
 const (
+	resourceServerDeletionProtectionKey                         = deletionProtectionKey
+	resourceServerGracefulShutdownKey                           = gracefulShutdownKey
 	resourceServerHostnameKey                                   = "hostname"
 	resourceServerLabelKey                                      = "label"
 	resourceServerLocationIDKey                                 = "location_id"
 	resourceServerPrimaryNetworkInterfaceDefaultFirewallRuleKey = "primary_network_interface_default_firewall_rule"
 	resourceServerPrimaryNetworkInterfaceLabelKey               = "primary_network_interface_label"
+	resourceServerPrimaryNetworkInterfaceRateLimitKey           = "primary_network_interface_rate_limit"
 	resourceServerPackageIDKey                                  = "package_id"
 	resourceServerRootPasswordKey                               = "root_password"
+	resourceServerSnapshotIDKey                                 = "snapshot_id"
+	resourceServerSSHKeyIDsKey                                  = "ssh_key_ids"
+	resourceServerSSHPublicKeysKey                              = "ssh_public_keys"
 	resourceServerTemplateIDKey                                 = "template_id"
+	resourceServerUserDataKey                                   = "user_data"
+	resourceServerWaitForSSHKey                                 = "wait_for_ssh"
+	resourceServerWaitForSSHPortKey                             = "port"
+	resourceServerWaitForSSHTimeoutKey                          = "timeout"
+
+	resourceServerGeneratedPasswordLength = 32
+)
+
+var (
+	resourceServerPasswordCharacterClasses = []string{
+		"ABCDEFGHJKLMNPQRSTUVWXYZ",
+		"abcdefghijkmnopqrstuvwxyz",
+		"23456789",
+		"!#%+-.:=?@_",
+	}
 )
 
 var (
-	serverMap      = make(map[string]*sync.Mutex)
-	serverMapMutex = &sync.Mutex{}
+	serverActions = newServerActionGate(serverActionDefaultLimit, serverActionSpacing)
+	serverLocks   = newServerLockManager(nil, serverLockDefaultTimeout)
 )
 
 // resourceServer manages a server.
 func resourceServer() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			resourceServerDeletionProtectionKey: deletionProtectionSchema(),
+			resourceServerDiskKey: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The additional disks managed by the server",
+				Elem:        resourceServerInlineDiskElem(),
+			},
+			resourceServerFirewallRuleKey: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The firewall rules for the primary network interface managed by the server",
+				Elem:        resourceServerInlineFirewallRuleElem(),
+			},
+			resourceServerGracefulShutdownKey: gracefulShutdownSchema("The settings for shutting down the server before it is deleted"),
 			resourceServerHostnameKey: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -70,19 +110,77 @@ func resourceServer() *schema.Resource {
 				Default:     "Primary Network Interface",
 				Description: "The label for the primary network interface",
 			},
+			resourceServerPrimaryNetworkInterfaceRateLimitKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The rate limit for the primary network interface",
+			},
 			resourceServerRootPasswordKey: {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The root password",
+				Optional:    true,
+				Computed:    true,
+				Description: "The root password (generated by the provider, if omitted)",
 				ForceNew:    true,
 				Sensitive:   true,
 			},
+			resourceServerSnapshotIDKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The identifier of the snapshot to create the server from",
+				ForceNew:     true,
+				ExactlyOneOf: []string{resourceServerSnapshotIDKey, resourceServerTemplateIDKey},
+			},
+			resourceServerSSHKeyIDsKey: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The identifiers for the account SSH keys to authorize for the root user",
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			resourceServerSSHPublicKeysKey: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The SSH public keys to authorize for the root user",
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			resourceServerTemplateIDKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The template identifier",
+				ForceNew:     true,
+				ExactlyOneOf: []string{resourceServerSnapshotIDKey, resourceServerTemplateIDKey},
+			},
+			resourceServerUserDataKey: {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The template identifier",
+				Optional:    true,
+				Description: "The cloud-init user data",
 				ForceNew:    true,
 			},
+			resourceServerWaitForSSHKey: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Wait for the SSH server to accept connections after the server has been created",
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						resourceServerWaitForSSHPortKey: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     22,
+							Description: "The SSH port",
+						},
+						resourceServerWaitForSSHTimeoutKey: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     300,
+							Description: "The number of seconds to wait for the SSH server before timing out",
+						},
+					},
+				},
+			},
 			dataSourceServerBootedKey: {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -97,26 +195,36 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's disk identifiers",
+				Deprecated:  "Use the 'disks' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceServerDiskLabelsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's disk labels",
+				Deprecated:  "Use the 'disks' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceServerDiskPrimaryKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "Whether a disk is the primary disk",
+				Deprecated:  "Use the 'disks' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeBool},
 			},
 			dataSourceServerDiskSizesKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's disk sizes in gigabytes",
+				Deprecated:  "Use the 'disks' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			dataSourceServerDisksKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server's disks",
+				Elem:        dataSourceDiskElem(),
+			},
 			dataSourceServerLocationNameKey: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -131,6 +239,7 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The IP addresses assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -140,12 +249,14 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The default firewall rules for the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceServerNetworkInterfaceFirewallRulesAddressesKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The CIDR blocks for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -155,6 +266,7 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The commands for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -164,6 +276,7 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The identifiers for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -173,6 +286,7 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The ports of the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -182,6 +296,7 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The protocols for the firewall rules assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -191,6 +306,7 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The gateways assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -200,18 +316,21 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's network interface identifiers",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceServerNetworkInterfaceLabelsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The server's network interface labels",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			dataSourceServerNetworkInterfaceNetmasksKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The netmasks assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -221,6 +340,7 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The networks assigned to the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem: &schema.Schema{
 					Type: schema.TypeList,
 					Elem: &schema.Schema{Type: schema.TypeString},
@@ -230,19 +350,32 @@ func resourceServer() *schema.Resource {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "Whether a network interface is the primary interface",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeBool},
 			},
 			dataSourceServerNetworkInterfaceRateLimitsKey: {
 				Type:        schema.TypeList,
 				Computed:    true,
 				Description: "The rate limits for the server's network interfaces",
+				Deprecated:  "Use the 'network_interfaces' attribute instead",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			dataSourceServerNetworkInterfacesKey: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The server's network interfaces",
+				Elem:        dataSourceNetworkInterfaceElem(),
+			},
 			dataSourceServerPackageNameKey: {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The package name",
 			},
+			dataSourceServerPrimaryIPv4AddressKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first public IPv4 address assigned to the primary network interface",
+			},
 			dataSourceServerTemplateNameKey: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -254,20 +387,274 @@ func resourceServer() *schema.Resource {
 		Read:   resourceServerRead,
 		Update: resourceServerUpdate,
 		Delete: resourceServerDelete,
+
+		CustomizeDiff: resourceServerCustomizeDiff,
 	}
 }
 
+// resourceServerCustomizeDiff validates the planned changes for a server.
+func resourceServerCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	// The wait_for_ssh block is validated during the plan phase as an invalid block would otherwise taint a new server.
+	if d.NewValueKnown(fmt.Sprintf("%s.0.%s", resourceServerWaitForSSHKey, resourceServerWaitForSSHPortKey)) && d.NewValueKnown(fmt.Sprintf("%s.0.%s", resourceServerWaitForSSHKey, resourceServerWaitForSSHTimeoutKey)) {
+		_, _, _, err := resourceServerWaitForSSHSettings(d.Get(resourceServerWaitForSSHKey).([]interface{}))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err := gracefulShutdownCustomizeDiff(d)
+
+	if err != nil {
+		return err
+	}
+
+	err = resourceServerNetworkInterfaceRateLimitCustomizeDiff(d, resourceServerPrimaryNetworkInterfaceRateLimitKey)
+
+	if err != nil {
+		return err
+	}
+
+	err = resourceServerValidateLocation(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange(resourceServerSSHPublicKeysKey) && d.NewValueKnown(resourceServerSSHPublicKeysKey) {
+		sshPublicKeys := d.Get(resourceServerSSHPublicKeysKey).([]interface{})
+		err = resourceServerValidateSSHPublicKeys(resourceServerStringList(sshPublicKeys))
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceServerReserveQuota(d, m)
+}
+
+// resourceServerReserveQuota reserves the account quota for a new server and any additional inline disks.
+func resourceServerReserveQuota(d *schema.ResourceDiff, m interface{}) error {
+	return accountQuotas.Reserve(m, accountQuotaKey("clouddk_server", d), resourceServerQuotaResources(d))
+}
+
+// resourceServerQuotaResources returns the resources created by the changes for a server.
+func resourceServerQuotaResources(d accountQuotaResource) map[string]int {
+	o, n := d.GetChange(resourceServerDiskKey)
+	disks := n.(*schema.Set).Len() - o.(*schema.Set).Len()
+
+	if disks < 0 {
+		disks = 0
+	}
+
+	if len(d.Id()) > 0 {
+		return map[string]int{accountQuotaDisks: disks}
+	}
+
+	// A new server comes with a primary disk and a public IP address.
+	return map[string]int{
+		accountQuotaDisks:       disks + 1,
+		accountQuotaIPAddresses: 1,
+		accountQuotaServers:     1,
+	}
+}
+
+// resourceServerValidateSSHPublicKeys verifies that a list of SSH public keys is in the authorized_keys format.
+func resourceServerValidateSSHPublicKeys(sshPublicKeys []string) error {
+	for i, v := range sshPublicKeys {
+		_, _, _, _, err := ssh.ParseAuthorizedKey([]byte(v))
+
+		if err != nil {
+			return fmt.Errorf("Invalid SSH public key at index %d - Reason: %s", i, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// resourceServerGeneratePassword generates a random password, which contains at least one character from each character class.
+func resourceServerGeneratePassword(length int) (string, error) {
+	if length < len(resourceServerPasswordCharacterClasses) {
+		return "", fmt.Errorf("The password length must be at least %d", len(resourceServerPasswordCharacterClasses))
+	}
+
+	randomIndex := func(n int) (int, error) {
+		i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+
+		if err != nil {
+			return 0, err
+		}
+
+		return int(i.Int64()), nil
+	}
+
+	characters := strings.Join(resourceServerPasswordCharacterClasses, "")
+	password := make([]byte, length)
+
+	for i := range password {
+		var set string
+
+		// The first characters guarantee that every class is represented, while the rest are picked from all classes.
+		if i < len(resourceServerPasswordCharacterClasses) {
+			set = resourceServerPasswordCharacterClasses[i]
+		} else {
+			set = characters
+		}
+
+		j, err := randomIndex(len(set))
+
+		if err != nil {
+			return "", err
+		}
+
+		password[i] = set[j]
+	}
+
+	// Shuffle the password to avoid a predictable position for each character class.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+
+		if err != nil {
+			return "", err
+		}
+
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+// resourceServerStringList converts a list of interfaces to a list of strings.
+func resourceServerStringList(list []interface{}) []string {
+	values := make([]string, len(list))
+
+	for i, v := range list {
+		values[i], _ = v.(string)
+	}
+
+	return values
+}
+
+// resourceServerValidateLocation verifies that the package and template are available in the location.
+func resourceServerValidateLocation(d *schema.ResourceDiff, m interface{}) error {
+	keys := []string{
+		resourceServerLocationIDKey,
+		resourceServerPackageIDKey,
+		resourceServerTemplateIDKey,
+	}
+
+	changed := false
+
+	for _, k := range keys {
+		changed = changed || d.HasChange(k)
+	}
+
+	// We cannot validate values which are not known until the apply phase.
+	if !changed || !d.NewValueKnown(resourceServerLocationIDKey) {
+		return nil
+	}
+
+	locationID := d.Get(resourceServerLocationIDKey).(string)
+	packageID := ""
+	templateID := ""
+
+	if d.NewValueKnown(resourceServerPackageIDKey) {
+		packageID = d.Get(resourceServerPackageIDKey).(string)
+	}
+
+	// Servers created from snapshots do not have a template until they have been created.
+	if d.NewValueKnown(resourceServerTemplateIDKey) {
+		templateID = d.Get(resourceServerTemplateIDKey).(string)
+	}
+
+	locations, err := dataSourceLocationsList(m)
+
+	if err != nil {
+		return err
+	}
+
+	var location *clouddk.LocationBody
+
+	for i, v := range locations {
+		if v.Identifier == locationID {
+			location = &locations[i]
+
+			break
+		}
+	}
+
+	if location == nil {
+		return fmt.Errorf("The location '%s' does not exist", locationID)
+	}
+
+	// Locations which do not list their packages or templates are assumed to offer all of them.
+	if len(location.Packages) > 0 && len(packageID) > 0 {
+		available := false
+
+		for _, v := range location.Packages {
+			available = available || v.Identifier == packageID
+		}
+
+		if !available {
+			return fmt.Errorf("The package '%s' is not available in location '%s'", packageID, locationID)
+		}
+	}
+
+	if len(location.Templates) > 0 && len(templateID) > 0 {
+		available := false
+
+		for _, v := range location.Templates {
+			available = available || v.Identifier == templateID
+		}
+
+		if !available {
+			return fmt.Errorf("The template '%s' is not available in location '%s'", templateID, locationID)
+		}
+	}
+
+	return nil
+}
+
 // resourceServerCreate creates a server.
 func resourceServerCreate(d *schema.ResourceData, m interface{}) error {
 	clientSettings := m.(clouddk.ClientSettings)
 
+	rootPassword := d.Get(resourceServerRootPasswordKey).(string)
+
+	if len(rootPassword) == 0 {
+		var err error
+		rootPassword, err = resourceServerGeneratePassword(resourceServerGeneratedPasswordLength)
+
+		if err != nil {
+			return fmt.Errorf("Failed to generate a root password - Reason: %s", err.Error())
+		}
+
+		d.Set(resourceServerRootPasswordKey, rootPassword)
+	}
+
 	body := clouddk.ServerCreateBody{
 		Hostname:            d.Get(resourceServerHostnameKey).(string),
 		Label:               d.Get(resourceServerLabelKey).(string),
-		InitialRootPassword: d.Get(resourceServerRootPasswordKey).(string),
+		InitialRootPassword: rootPassword,
 		Package:             d.Get(resourceServerPackageIDKey).(string),
 		Template:            d.Get(resourceServerTemplateIDKey).(string),
+		Snapshot:            d.Get(resourceServerSnapshotIDKey).(string),
 		Location:            d.Get(resourceServerLocationIDKey).(string),
+		UserData:            d.Get(resourceServerUserDataKey).(string),
+		SSHKeys:             resourceServerStringList(d.Get(resourceServerSSHPublicKeysKey).([]interface{})),
+	}
+
+	// The account SSH keys are injected in the same way as the public keys specified directly.
+	for _, v := range resourceServerStringList(d.Get(resourceServerSSHKeyIDsKey).([]interface{})) {
+		sshKey, err := resourceSSHKeyGet(m, v)
+
+		if err != nil {
+			return err
+		} else if sshKey == nil {
+			return fmt.Errorf("Failed to create the server - Reason: The SSH key (id: %s) does not exist", v)
+		}
+
+		body.SSHKeys = append(body.SSHKeys, sshKey.PublicKey)
 	}
 
 	reqBody := new(bytes.Buffer)
@@ -277,13 +664,21 @@ func resourceServerCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	// Due to an API issue which causes global server actions to fail, if we perform them too fast, we need to do one action at a time.
-	res, err := clouddk.DoClientRequest(&clientSettings, "POST", "cloudservers", reqBody, []int{200}, 60, 10)
+	var res *http.Response
+
+	// Due to an API issue which causes global server actions to fail, if we perform them too fast, we need to limit the number of concurrent actions.
+	err = serverActions.WithServerAction("create server", func() (err error) {
+		res, err = clouddk.DoClientRequest(&clientSettings, "POST", "cloudservers", reqBody, []int{200}, 60, 10)
+
+		return err
+	})
 
 	if err != nil {
 		return err
 	}
 
+	accountQuotas.Commit("", resourceServerQuotaResources(d))
+
 	server := clouddk.ServerBody{}
 	err = json.NewDecoder(res.Body).Decode(&server)
 
@@ -297,49 +692,101 @@ func resourceServerCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if d.Get(dataSourceServerBootedKey).(bool) {
-		return nil
+	// Wait for the server to boot before proceeding as we may otherwise cause timeouts in provisioners.
+	if !d.Get(dataSourceServerBootedKey).(bool) {
+		err = resourceServerWaitForBootFlag(d, m, &server)
+
+		if err != nil {
+			return err
+		}
 	}
 
-	// Wait for the server to boot before proceeding as we may otherwise cause timeouts in provisioners.
-	err = resourceServerWaitForBootFlag(d, m, &server)
+	// We should now be able to change the properties for the primary network interface.
+	err = resourceServerWithLock(m, d.Id(), "configure primary network interface", func() error {
+		err := resourceServerUpdatePrimaryNetworkInterface(d, m, &server)
+
+		if err != nil {
+			return err
+		}
+
+		// The inline disks and firewall rules are created in the same sequence to avoid competing with other operations.
+		updatedServer, err := resourceServerUpdateInline(d, m, &server)
+
+		if err != nil {
+			return err
+		}
+
+		server = *updatedServer
+
+		err = dataSourceServerReadResponseBody(d, m, &server)
+
+		if err != nil {
+			return err
+		}
+
+		resourceServerSetConnInfo(d, &server)
+
+		return nil
+	})
 
 	if err != nil {
 		return err
 	}
 
-	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err = resourceServerLock(d, m, d.Id())
+	// The boot flag is toggled before the SSH server is ready, which is why provisioners may fail unless we wait for it.
+	return resourceServerWaitForSSH(d, &server)
+}
+
+// resourceServerWaitForSSH waits for the SSH server on the primary IPv4 address to accept connections, if requested.
+func resourceServerWaitForSSH(d *schema.ResourceData, server *clouddk.ServerBody) error {
+	port, timeout, wait, err := resourceServerWaitForSSHSettings(d.Get(resourceServerWaitForSSHKey).([]interface{}))
 
-	if err != nil {
+	if err != nil || !wait {
 		return err
 	}
 
-	// We should now be able to change the properties for the primary network interface.
-	err = resourceServerUpdatePrimaryNetworkInterface(d, m, &server)
-
-	if err != nil {
-		resourceServerUnlock(d, m, d.Id())
+	host := dataSourceServerPrimaryIPv4Address(server)
 
-		return nil
+	if len(host) == 0 {
+		return fmt.Errorf("Failed to wait for SSH server - Reason: The server (id: %s) has no primary IPv4 address", server.Identifier)
 	}
 
-	err = dataSourceServerReadResponseBody(d, m, &server)
+	return waitForSSH(net.JoinHostPort(host, strconv.Itoa(port)), timeout, serverSSHRetryDelay)
+}
 
-	if err != nil {
-		resourceServerUnlock(d, m, d.Id())
+// resourceServerWaitForSSHSettings returns the port and timeout for the wait_for_ssh block and whether the block has been specified.
+func resourceServerWaitForSSHSettings(waitForSSHList []interface{}) (int, time.Duration, bool, error) {
+	if len(waitForSSHList) == 0 || waitForSSHList[0] == nil {
+		return 0, 0, false, nil
+	}
 
-		return nil
+	waitForSSHBlock := waitForSSHList[0].(map[string]interface{})
+	port := waitForSSHBlock[resourceServerWaitForSSHPortKey].(int)
+	timeout := waitForSSHBlock[resourceServerWaitForSSHTimeoutKey].(int)
+
+	if port < 1 || port > 65535 {
+		return 0, 0, false, fmt.Errorf("Invalid SSH port %d (must be between 1 and 65535)", port)
+	} else if timeout < 1 {
+		return 0, 0, false, fmt.Errorf("Invalid SSH timeout %d (must be greater than zero)", timeout)
 	}
 
-	// We need to release the lock for the server to allow other operations to continue.
-	err = resourceServerUnlock(d, m, d.Id())
+	return port, time.Duration(timeout) * time.Second, true, nil
+}
 
-	if err != nil {
-		return err
+// resourceServerSetConnInfo sets the default connection information for provisioners.
+func resourceServerSetConnInfo(d *schema.ResourceData, server *clouddk.ServerBody) {
+	host := dataSourceServerPrimaryIPv4Address(server)
+
+	if len(host) == 0 {
+		return
 	}
 
-	return nil
+	d.SetConnInfo(map[string]string{
+		"type":     "ssh",
+		"host":     host,
+		"user":     "root",
+		"password": d.Get(resourceServerRootPasswordKey).(string),
+	})
 }
 
 // resourceServerRead reads information about an existing server.
@@ -384,111 +831,140 @@ func resourceServerRead(d *schema.ResourceData, m interface{}) error {
 		if v.Primary {
 			d.Set(resourceServerPrimaryNetworkInterfaceDefaultFirewallRuleKey, v.DefaultFirewallRule)
 			d.Set(resourceServerPrimaryNetworkInterfaceLabelKey, v.Label)
+			d.Set(resourceServerPrimaryNetworkInterfaceRateLimitKey, int(v.RateLimit))
 
 			break
 		}
 	}
 
+	resourceServerInlineRead(d, &server)
+
 	return nil
 }
 
-// resourceServerUpdate updates an existing server.
-func resourceServerUpdate(d *schema.ResourceData, m interface{}) error {
+// resourceServerGet retrieves a server.
+func resourceServerGet(m interface{}, serverID string) (*clouddk.ServerBody, error) {
 	clientSettings := m.(clouddk.ClientSettings)
 
-	body := clouddk.ServerUpdateBody{
-		Hostname: d.Get(resourceServerHostnameKey).(string),
-		Label:    d.Get(resourceServerLabelKey).(string),
-	}
-
-	reqBody := new(bytes.Buffer)
-	err := json.NewEncoder(reqBody).Encode(body)
+	res, err := clouddk.DoClientRequest(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s", serverID), new(bytes.Buffer), []int{200}, 60, 10)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err = resourceServerLock(d, m, d.Id())
+	server := clouddk.ServerBody{}
+	err = json.NewDecoder(res.Body).Decode(&server)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// We should now be able to proceed without any issues.
-	res, err := clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("cloudservers/%s", d.Id()), reqBody, []int{200}, 60, 10)
+	return &server, nil
+}
 
-	if err != nil {
-		return err
+// resourceServerUpdate updates an existing server.
+func resourceServerUpdate(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	body := clouddk.ServerUpdateBody{
+		Hostname: d.Get(resourceServerHostnameKey).(string),
+		Label:    d.Get(resourceServerLabelKey).(string),
 	}
 
-	server := clouddk.ServerBody{}
-	err = json.NewDecoder(res.Body).Decode(&server)
+	reqBody := new(bytes.Buffer)
+	err := json.NewEncoder(reqBody).Encode(body)
 
 	if err != nil {
 		return err
 	}
 
-	// We also need to upgrade the settings for the primary network interface.
-	err = resourceServerUpdatePrimaryNetworkInterface(d, m, &server)
+	return resourceServerWithLock(m, d.Id(), "update server", func() error {
+		res, err := clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("cloudservers/%s", d.Id()), reqBody, []int{200}, 60, 10)
 
-	if err != nil {
-		resourceServerUnlock(d, m, d.Id())
+		if err != nil {
+			return err
+		}
 
-		return err
-	}
+		server := clouddk.ServerBody{}
+		err = json.NewDecoder(res.Body).Decode(&server)
 
-	// In case the package has changed, we need to upgrade or downgrade the server.
-	if d.HasChange(resourceServerPackageIDKey) {
-		upgradeBody := clouddk.ServerUpgradeBody{
-			Package:     d.Get(resourceServerPackageIDKey).(string),
-			UpgradeDisk: false,
+		if err != nil {
+			return err
 		}
 
-		reqBody = new(bytes.Buffer)
-		err = json.NewEncoder(reqBody).Encode(upgradeBody)
+		// We also need to upgrade the settings for the primary network interface.
+		err = resourceServerUpdatePrimaryNetworkInterface(d, m, &server)
 
 		if err != nil {
-			resourceServerUnlock(d, m, d.Id())
-
 			return err
 		}
 
-		res, resErr := clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/upgrade", d.Id()), reqBody, []int{200}, 60, 10)
+		// In case the package has changed, we need to upgrade or downgrade the server.
+		if d.HasChange(resourceServerPackageIDKey) {
+			upgradeBody := clouddk.ServerUpgradeBody{
+				Package:     d.Get(resourceServerPackageIDKey).(string),
+				UpgradeDisk: false,
+			}
+
+			upgradeReqBody := new(bytes.Buffer)
+			err = json.NewEncoder(upgradeReqBody).Encode(upgradeBody)
+
+			if err != nil {
+				return err
+			}
+
+			err = serverActions.WithServerAction("upgrade server", func() (err error) {
+				res, err = clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/upgrade", d.Id()), upgradeReqBody, []int{200}, 60, 10)
+
+				return err
+			})
+
+			if err != nil {
+				return err
+			}
 
-		if resErr != nil {
-			resourceServerUnlock(d, m, d.Id())
+			server = clouddk.ServerBody{}
+			err = json.NewDecoder(res.Body).Decode(&server)
 
-			return resErr
+			if err != nil {
+				return err
+			}
 		}
 
-		server = clouddk.ServerBody{}
-		err = json.NewDecoder(res.Body).Decode(&server)
+		// The inline disks and firewall rules must be reconciled after the upgrade as the primary disk may have been replaced.
+		updatedServer, err := resourceServerUpdateInline(d, m, &server)
 
 		if err != nil {
-			resourceServerUnlock(d, m, d.Id())
-
 			return err
 		}
+
+		accountQuotas.Commit(accountQuotaKey("clouddk_server", d), resourceServerQuotaResources(d))
+
+		// Ensure that we update the resource with the latest values.
+		return dataSourceServerReadResponseBody(d, m, updatedServer)
+	})
+}
+
+// resourceServerUpdateInline reconciles the inline disks and firewall rules and returns the refreshed server.
+// The caller must hold the lock for the server.
+func resourceServerUpdateInline(d *schema.ResourceData, m interface{}, server *clouddk.ServerBody) (*clouddk.ServerBody, error) {
+	if !d.HasChange(resourceServerDiskKey) && !d.HasChange(resourceServerFirewallRuleKey) {
+		return server, nil
 	}
 
-	// Ensure that we update the resource with the latest values.
-	err = dataSourceServerReadResponseBody(d, m, &server)
+	err := resourceServerInlineReconcileDisks(d, m, server.Identifier)
 
 	if err != nil {
-		resourceServerUnlock(d, m, d.Id())
-
-		return err
+		return nil, err
 	}
 
-	// We need to release the lock for the server to allow other operations to continue.
-	err = resourceServerUnlock(d, m, d.Id())
+	err = resourceServerInlineReconcileFirewallRules(d, m, server)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resourceServerGet(m, server.Identifier)
 }
 
 // resourceServerUpdatePrimaryNetworkInterface updates the primary interface on an existing server.
@@ -509,13 +985,20 @@ func resourceServerUpdatePrimaryNetworkInterface(d *schema.ResourceData, m inter
 
 	clientSettings := m.(clouddk.ClientSettings)
 
+	rateLimit, err := resourceServerNetworkInterfaceRateLimit(d, resourceServerPrimaryNetworkInterfaceRateLimitKey, &server.NetworkInterfaces[networkInterfaceIndex])
+
+	if err != nil {
+		return err
+	}
+
 	networkInterfaceUpdateBody := clouddk.NetworkInterfaceUpdateBody{
 		DefaultFirewallRule: d.Get(resourceServerPrimaryNetworkInterfaceDefaultFirewallRuleKey).(string),
 		Label:               d.Get(resourceServerPrimaryNetworkInterfaceLabelKey).(string),
+		RateLimit:           rateLimit,
 	}
 
 	reqBody := new(bytes.Buffer)
-	err := json.NewEncoder(reqBody).Encode(networkInterfaceUpdateBody)
+	err = json.NewEncoder(reqBody).Encode(networkInterfaceUpdateBody)
 
 	if err != nil {
 		return err
@@ -539,26 +1022,66 @@ func resourceServerUpdatePrimaryNetworkInterface(d *schema.ResourceData, m inter
 	return nil
 }
 
+// resourceServerNetworkInterfaceRateLimit determines the rate limit for a network interface.
+// The current rate limit is preserved, if the argument has not been specified.
+func resourceServerNetworkInterfaceRateLimit(d *schema.ResourceData, key string, networkInterface *clouddk.NetworkInterfaceBody) (clouddk.CustomInt, error) {
+	// The rate limit is always included in the update request, which is why an explicit zero must be distinguished from an unset value.
+	rateLimit, ok := d.GetOkExists(key)
+
+	if !ok {
+		return networkInterface.RateLimit, nil
+	} else if rateLimit.(int) < 0 {
+		return 0, fmt.Errorf("Invalid rate limit %d for network interface (id: %s) - Reason: The rate limit cannot be negative", rateLimit.(int), networkInterface.Identifier)
+	}
+
+	return clouddk.CustomInt(rateLimit.(int)), nil
+}
+
+// resourceServerNetworkInterfaceRateLimitCustomizeDiff rejects negative rate limits during the plan phase.
+func resourceServerNetworkInterfaceRateLimitCustomizeDiff(d *schema.ResourceDiff, key string) error {
+	if !d.NewValueKnown(key) {
+		return nil
+	}
+
+	if rateLimit := d.Get(key).(int); rateLimit < 0 {
+		return fmt.Errorf("Invalid rate limit %d (must be zero or greater)", rateLimit)
+	}
+
+	return nil
+}
+
 // resourceServerDelete deletes an existing server.
 func resourceServerDelete(d *schema.ResourceData, m interface{}) error {
-	clientSettings := m.(clouddk.ClientSettings)
-
-	// We need to acquire the lock for the server to reduce the risk of race conditions.
-	err := resourceServerLock(d, m, d.Id())
+	err := deletionProtectionCheck(d, "server")
 
 	if err != nil {
 		return err
 	}
 
-	// We should now be able to proceed without any issues.
-	_, err = clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s", d.Id()), new(bytes.Buffer), []int{200, 404}, 60, 10)
+	shutdownTimeout, shutdown, err := gracefulShutdownTimeout(d)
 
 	if err != nil {
 		return err
 	}
 
-	// We need to release the lock for the server to allow other operations to continue.
-	err = resourceServerUnlock(d, m, d.Id())
+	clientSettings := m.(clouddk.ClientSettings)
+
+	err = resourceServerWithLock(m, d.Id(), "delete server", func() error {
+		// Shutting down the server first allows the operating system to flush its buffers to the disks.
+		if shutdown {
+			_, err := resourceServerShutdown(m, d.Id(), shutdownTimeout)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return serverActions.WithServerAction("delete server", func() error {
+			_, err := clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s", d.Id()), new(bytes.Buffer), []int{200, 404}, 60, 10)
+
+			return err
+		})
+	})
 
 	if err != nil {
 		return err
@@ -569,38 +1092,47 @@ func resourceServerDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-// resourceServerLock acquires the lock for a specific server.
-func resourceServerLock(d *schema.ResourceData, m interface{}, serverID string) error {
-	clientSettings := m.(clouddk.ClientSettings)
+// resourceServerWithLock acquires the lock for a specific server, waits for its transactions to end and invokes fn.
+func resourceServerWithLock(m interface{}, serverID string, operation string, fn func() error) error {
+	return serverLocks.WithServerLock(context.Background(), serverID, operation, func() error {
+		err := resourceServerWaitForTransactions(m, serverID)
 
-	retryLimit := 90
-	retryDelay := 10
+		if err != nil {
+			return err
+		}
 
-	// Acquire the lock for the serverMap variable.
-	log.Printf("[DEBUG] Acquiring lock for server map (id: %s)", serverID)
-	serverMapMutex.Lock()
+		return fn()
+	})
+}
 
-	// Create a mutex for the specified server, if none already exists.
-	if serverMap[serverID] == nil {
-		log.Printf("[DEBUG] Creating mutex for server (id: %s)", serverID)
-		serverMap[serverID] = &sync.Mutex{}
-	}
+// resourceServerWithLocks acquires the locks for multiple servers, waits for their transactions to end and invokes fn.
+func resourceServerWithLocks(m interface{}, serverIDs []string, operation string, fn func() error) error {
+	return serverLocks.WithServerLocks(context.Background(), serverIDs, operation, func() error {
+		for _, v := range serverIDs {
+			err := resourceServerWaitForTransactions(m, v)
 
-	// Now that we know a mutex for the server exists, we can unlock the mutex for the map and acquire the lock for the server instead.
-	log.Printf("[DEBUG] Releasing lock for server map (id: %s)", serverID)
-	serverMapMutex.Unlock()
+			if err != nil {
+				return err
+			}
+		}
 
-	log.Printf("[DEBUG] Acquiring lock for server (id: %s)", serverID)
-	serverMap[serverID].Lock()
+		return fn()
+	})
+}
 
-	// We can now go ahead and retrieve the transactions for the server. We will keep doing this until all transactions are eithed failed or completed.
+// resourceServerWaitForTransactions waits for all pending and running transactions for a specific server to end.
+func resourceServerWaitForTransactions(m interface{}, serverID string) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	retryLimit := 90
+	retryDelay := 10
+
+	// We will keep retrieving the transactions for the server until all of them are either failed or completed.
 	timeDelay := int64(retryDelay)
 	timeMax := float64(retryLimit * retryDelay)
 	timeStart := time.Now()
 	timeElapsed := timeStart.Sub(timeStart)
 
-	continueToWait := false
-
 	for timeElapsed.Seconds() < timeMax {
 		if int64(timeElapsed.Seconds())%timeDelay == 0 {
 			res, err := clouddk.DoClientRequest(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/logs", serverID), new(bytes.Buffer), []int{200}, 1, 1)
@@ -616,7 +1148,7 @@ func resourceServerLock(d *schema.ResourceData, m interface{}, serverID string)
 				return err
 			}
 
-			continueToWait = false
+			continueToWait := false
 
 			for _, v := range logsList {
 				if v.Status == "pending" || v.Status == "running" {
@@ -627,7 +1159,7 @@ func resourceServerLock(d *schema.ResourceData, m interface{}, serverID string)
 			}
 
 			if !continueToWait {
-				break
+				return nil
 			}
 
 			time.Sleep(1 * time.Second)
@@ -638,27 +1170,7 @@ func resourceServerLock(d *schema.ResourceData, m interface{}, serverID string)
 		timeElapsed = time.Now().Sub(timeStart)
 	}
 
-	// Throw an error in case there are still transactions pending or running
-	if continueToWait {
-		log.Printf("[DEBUG] Releasing lock for server (id: %s)", serverID)
-		serverMap[serverID].Unlock()
-
-		return fmt.Errorf("Timeout while waiting for transactions to end (id: %s)", serverID)
-	}
-
-	return nil
-}
-
-// resourceServerUnlock releases the lock for a specific server.
-func resourceServerUnlock(d *schema.ResourceData, m interface{}, serverID string) error {
-	if serverMap[serverID] == nil {
-		return fmt.Errorf("Cannot unlock a server which has never been locked during this session (id: %s)", serverID)
-	}
-
-	log.Printf("[DEBUG] Releasing lock for server (id: %s)", serverID)
-	serverMap[serverID].Unlock()
-
-	return nil
+	return fmt.Errorf("Timeout while waiting for transactions to end (id: %s)", serverID)
 }
 
 // resourceServerWaitForBootFlag waits for the boot flag to be toggled.
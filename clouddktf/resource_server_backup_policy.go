@@ -0,0 +1,241 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	resourceServerBackupPolicyEnabledKey   = "enabled"
+	resourceServerBackupPolicyHourKey      = "hour"
+	resourceServerBackupPolicyRetentionKey = "retention"
+	resourceServerBackupPolicyScheduleKey  = "schedule"
+	resourceServerBackupPolicyServerIDKey  = "server_id"
+
+	resourceServerBackupPolicyScheduleDaily  = "daily"
+	resourceServerBackupPolicyScheduleWeekly = "weekly"
+)
+
+// resourceServerBackupPolicy manages the backup policy for a server.
+func resourceServerBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			resourceServerBackupPolicyEnabledKey: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether automatic backups are enabled",
+			},
+			resourceServerBackupPolicyHourKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The hour of the day (0-23) at which backups are created",
+			},
+			resourceServerBackupPolicyRetentionKey: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     7,
+				Description: "The number of backups to retain",
+			},
+			resourceServerBackupPolicyScheduleKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     resourceServerBackupPolicyScheduleDaily,
+				Description: "The backup schedule (daily or weekly)",
+			},
+			resourceServerBackupPolicyServerIDKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The server identifier",
+				ForceNew:    true,
+			},
+		},
+
+		CustomizeDiff: resourceServerBackupPolicyCustomizeDiff,
+
+		Create: resourceServerBackupPolicyCreate,
+		Read:   resourceServerBackupPolicyRead,
+		Update: resourceServerBackupPolicyUpdate,
+		Delete: resourceServerBackupPolicyDelete,
+	}
+}
+
+// resourceServerBackupPolicyCustomizeDiff validates the backup policy during the plan phase.
+func resourceServerBackupPolicyCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	keys := []string{
+		resourceServerBackupPolicyHourKey,
+		resourceServerBackupPolicyRetentionKey,
+		resourceServerBackupPolicyScheduleKey,
+	}
+
+	// The policy cannot be validated until all the values are known.
+	for _, k := range keys {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	body := clouddk.BackupPolicyBody{
+		Hour:      clouddk.CustomInt(d.Get(resourceServerBackupPolicyHourKey).(int)),
+		Retention: clouddk.CustomInt(d.Get(resourceServerBackupPolicyRetentionKey).(int)),
+		Schedule:  d.Get(resourceServerBackupPolicyScheduleKey).(string),
+	}
+
+	return resourceServerBackupPolicyValidate(&body)
+}
+
+// resourceServerBackupPolicyCreate creates the backup policy for a server.
+func resourceServerBackupPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	d.SetId(d.Get(resourceServerBackupPolicyServerIDKey).(string))
+
+	err := resourceServerBackupPolicyUpdate(d, m)
+
+	if err != nil {
+		d.SetId("")
+	}
+
+	return err
+}
+
+// resourceServerBackupPolicyRead reads information about the backup policy for a server.
+func resourceServerBackupPolicyRead(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	serverID := d.Id()
+
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/backup-policy", serverID), new(bytes.Buffer))
+
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return err
+	} else if res.StatusCode != 200 {
+		if res.StatusCode == 404 {
+			d.SetId("")
+
+			return nil
+		}
+
+		return fmt.Errorf("Failed to read the backup policy information - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	policy := clouddk.BackupPolicyBody{}
+	err = json.NewDecoder(res.Body).Decode(&policy)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceServerBackupPolicyReadResponseBody(d, m, &policy)
+}
+
+// resourceServerBackupPolicyReadResponseBody parses information about the backup policy for a server.
+func resourceServerBackupPolicyReadResponseBody(d *schema.ResourceData, m interface{}, policy *clouddk.BackupPolicyBody) error {
+	d.Set(resourceServerBackupPolicyEnabledKey, bool(policy.Enabled))
+	d.Set(resourceServerBackupPolicyHourKey, int(policy.Hour))
+	d.Set(resourceServerBackupPolicyRetentionKey, int(policy.Retention))
+	d.Set(resourceServerBackupPolicyScheduleKey, policy.Schedule)
+	d.Set(resourceServerBackupPolicyServerIDKey, d.Id())
+
+	return nil
+}
+
+// resourceServerBackupPolicyUpdate updates the backup policy for a server.
+func resourceServerBackupPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	body := clouddk.BackupPolicyBody{
+		Enabled:   clouddk.CustomBool(d.Get(resourceServerBackupPolicyEnabledKey).(bool)),
+		Hour:      clouddk.CustomInt(d.Get(resourceServerBackupPolicyHourKey).(int)),
+		Retention: clouddk.CustomInt(d.Get(resourceServerBackupPolicyRetentionKey).(int)),
+		Schedule:  d.Get(resourceServerBackupPolicyScheduleKey).(string),
+	}
+
+	res, err := resourceServerBackupPolicyPut(d.Id(), "update backup policy", &body, m)
+
+	if err != nil {
+		return err
+	}
+
+	policy := clouddk.BackupPolicyBody{}
+	err = json.NewDecoder(res.Body).Decode(&policy)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceServerBackupPolicyReadResponseBody(d, m, &policy)
+}
+
+// resourceServerBackupPolicyDelete disables automatic backups for a server.
+func resourceServerBackupPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	body := clouddk.BackupPolicyBody{
+		Enabled:   false,
+		Hour:      clouddk.CustomInt(d.Get(resourceServerBackupPolicyHourKey).(int)),
+		Retention: clouddk.CustomInt(d.Get(resourceServerBackupPolicyRetentionKey).(int)),
+		Schedule:  d.Get(resourceServerBackupPolicyScheduleKey).(string),
+	}
+
+	_, err := resourceServerBackupPolicyPut(d.Id(), "delete backup policy", &body, m)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceServerBackupPolicyPut submits the backup policy for a server.
+func resourceServerBackupPolicyPut(serverID string, operation string, body *clouddk.BackupPolicyBody, m interface{}) (*http.Response, error) {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	reqBody := new(bytes.Buffer)
+	err := json.NewEncoder(reqBody).Encode(body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var res *http.Response
+
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	err = resourceServerWithLock(m, serverID, operation, func() (err error) {
+		res, err = clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("cloudservers/%s/backup-policy", serverID), reqBody, []int{200}, 60, 10)
+
+		return err
+	})
+
+	return res, err
+}
+
+// resourceServerBackupPolicyValidate validates a backup policy.
+func resourceServerBackupPolicyValidate(policy *clouddk.BackupPolicyBody) error {
+	if policy.Schedule != resourceServerBackupPolicyScheduleDaily && policy.Schedule != resourceServerBackupPolicyScheduleWeekly {
+		return fmt.Errorf("Invalid backup schedule '%s' (must be one of: %s, %s)", policy.Schedule, resourceServerBackupPolicyScheduleDaily, resourceServerBackupPolicyScheduleWeekly)
+	}
+
+	if policy.Hour < 0 || policy.Hour > 23 {
+		return fmt.Errorf("Invalid backup hour %d (must be between 0 and 23)", policy.Hour)
+	}
+
+	if policy.Retention < 1 {
+		return fmt.Errorf("Invalid backup retention %d (must be at least 1)", policy.Retention)
+	}
+
+	return nil
+}
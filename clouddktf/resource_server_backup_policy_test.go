@@ -0,0 +1,97 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// TestResourceServerBackupPolicyInstantiation tests whether the resourceServerBackupPolicy instance can be instantiated.
+func TestResourceServerBackupPolicyInstantiation(t *testing.T) {
+	s := resourceServerBackupPolicy()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceServerBackupPolicy")
+	}
+}
+
+// TestResourceServerBackupPolicySchema tests the resourceServerBackupPolicy schema.
+func TestResourceServerBackupPolicySchema(t *testing.T) {
+	s := resourceServerBackupPolicy()
+
+	if s.Schema[resourceServerBackupPolicyServerIDKey] == nil {
+		t.Fatalf("Error in resourceServerBackupPolicy.Schema: Missing argument \"%s\"", resourceServerBackupPolicyServerIDKey)
+	}
+
+	if s.Schema[resourceServerBackupPolicyServerIDKey].Required != true {
+		t.Fatalf("Error in resourceServerBackupPolicy.Schema: Argument \"%s\" is not required", resourceServerBackupPolicyServerIDKey)
+	}
+
+	optionalKeys := []string{
+		resourceServerBackupPolicyEnabledKey,
+		resourceServerBackupPolicyHourKey,
+		resourceServerBackupPolicyRetentionKey,
+		resourceServerBackupPolicyScheduleKey,
+	}
+
+	for _, v := range optionalKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourceServerBackupPolicy.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Optional != true {
+			t.Fatalf("Error in resourceServerBackupPolicy.Schema: Argument \"%s\" is not optional", v)
+		}
+	}
+}
+
+// TestResourceServerBackupPolicyValidate tests the resourceServerBackupPolicyValidate function.
+func TestResourceServerBackupPolicyValidate(t *testing.T) {
+	valid := clouddk.BackupPolicyBody{Enabled: true, Schedule: resourceServerBackupPolicyScheduleWeekly, Hour: 3, Retention: 4}
+
+	if err := resourceServerBackupPolicyValidate(&valid); err != nil {
+		t.Fatalf("Error in resourceServerBackupPolicyValidate: %s", err.Error())
+	}
+
+	invalid := []clouddk.BackupPolicyBody{
+		{Schedule: "hourly", Hour: 3, Retention: 4},
+		{Schedule: resourceServerBackupPolicyScheduleDaily, Hour: 24, Retention: 4},
+		{Schedule: resourceServerBackupPolicyScheduleDaily, Hour: 3, Retention: 0},
+	}
+
+	for _, v := range invalid {
+		if resourceServerBackupPolicyValidate(&v) == nil {
+			t.Fatalf("Error in resourceServerBackupPolicyValidate: Invalid policy %+v is accepted", v)
+		}
+	}
+}
+
+// TestResourceServerBackupPolicyCustomizeDiff tests whether invalid backup policies are rejected during the plan phase.
+func TestResourceServerBackupPolicyCustomizeDiff(t *testing.T) {
+	r := resourceServerBackupPolicy()
+
+	tests := []struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		{map[string]interface{}{resourceServerBackupPolicyServerIDKey: "example"}, true},
+		{map[string]interface{}{resourceServerBackupPolicyServerIDKey: "example", resourceServerBackupPolicyScheduleKey: "hourly"}, false},
+		{map[string]interface{}{resourceServerBackupPolicyServerIDKey: "example", resourceServerBackupPolicyHourKey: 24}, false},
+		{map[string]interface{}{resourceServerBackupPolicyServerIDKey: "example", resourceServerBackupPolicyRetentionKey: 0}, false},
+	}
+
+	for _, v := range tests {
+		_, err := r.Diff(nil, terraform.NewResourceConfigRaw(v.config), nil)
+
+		if v.valid && err != nil {
+			t.Fatalf("Error in resourceServerBackupPolicyCustomizeDiff: Valid configuration %+v is rejected - Reason: %s", v.config, err.Error())
+		} else if !v.valid && err == nil {
+			t.Fatalf("Error in resourceServerBackupPolicyCustomizeDiff: Invalid configuration %+v is accepted", v.config)
+		}
+	}
+}
@@ -0,0 +1,385 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	resourceServerDiskKey         = "disk"
+	resourceServerFirewallRuleKey = "firewall_rule"
+	resourceServerInlineIDKey     = "id"
+)
+
+// resourceServerInlineDiskElem returns the schema for an inline disk block.
+func resourceServerInlineDiskElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceDiskIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The disk identifier",
+			},
+			dataSourceDiskLabelKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The disk label",
+			},
+			dataSourceDiskSizeKey: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The disk size in gigabytes",
+			},
+		},
+	}
+}
+
+// resourceServerInlineFirewallRuleElem returns the schema for an inline firewall rule block.
+func resourceServerInlineFirewallRuleElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			dataSourceFirewallRuleAddressKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CIDR block for the firewall rule",
+			},
+			dataSourceFirewallRuleCommandKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The command for the firewall rule",
+			},
+			dataSourceFirewallRuleIDKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The firewall rule identifier",
+			},
+			dataSourceFirewallRulePortKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The port for the firewall rule",
+			},
+			dataSourceFirewallRuleProtocolKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The protocol for the firewall rule",
+			},
+		},
+	}
+}
+
+// resourceServerInlineFlattenDisk converts a disk to an inline disk block.
+func resourceServerInlineFlattenDisk(disk *clouddk.DiskBody) map[string]interface{} {
+	return map[string]interface{}{
+		dataSourceDiskIDKey:    disk.Identifier,
+		dataSourceDiskLabelKey: disk.Label,
+		dataSourceDiskSizeKey:  int(disk.Size),
+	}
+}
+
+// resourceServerInlineFlattenFirewallRule converts a firewall rule to an inline firewall rule block.
+func resourceServerInlineFlattenFirewallRule(firewallRule *clouddk.FirewallRuleBody) map[string]interface{} {
+	return map[string]interface{}{
+		dataSourceFirewallRuleAddressKey:  fmt.Sprintf("%s/%d", firewallRule.Address, firewallRule.Bits),
+		dataSourceFirewallRuleCommandKey:  firewallRule.Command,
+		dataSourceFirewallRuleIDKey:       firewallRule.Identifier,
+		dataSourceFirewallRulePortKey:     firewallRule.Port,
+		dataSourceFirewallRuleProtocolKey: firewallRule.Protocol,
+	}
+}
+
+// resourceServerInlineRead refreshes the inline blocks.
+// Disks and firewall rules which are not managed by the blocks are ignored to allow the separate resources to be used alongside the blocks.
+func resourceServerInlineRead(d *schema.ResourceData, server *clouddk.ServerBody) {
+	disks := map[string]map[string]interface{}{}
+
+	for i := range server.Disks {
+		disks[server.Disks[i].Identifier] = resourceServerInlineFlattenDisk(&server.Disks[i])
+	}
+
+	firewallRules := map[string]map[string]interface{}{}
+
+	for _, v := range server.NetworkInterfaces {
+		if v.Primary {
+			for i := range v.FirewallRules {
+				firewallRules[v.FirewallRules[i].Identifier] = resourceServerInlineFlattenFirewallRule(&v.FirewallRules[i])
+			}
+
+			break
+		}
+	}
+
+	d.Set(resourceServerDiskKey, resourceServerInlineRefresh(d.Get(resourceServerDiskKey).(*schema.Set).List(), disks))
+	d.Set(resourceServerFirewallRuleKey, resourceServerInlineRefresh(d.Get(resourceServerFirewallRuleKey).(*schema.Set).List(), firewallRules))
+}
+
+// resourceServerInlineRefresh replaces the blocks with the current objects and drops the blocks whose objects no longer exist.
+func resourceServerInlineRefresh(blocks []interface{}, objects map[string]map[string]interface{}) []interface{} {
+	refreshed := make([]interface{}, 0, len(blocks))
+
+	for _, v := range blocks {
+		block, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		id, _ := block[resourceServerInlineIDKey].(string)
+
+		if object, ok := objects[id]; ok {
+			refreshed = append(refreshed, object)
+		}
+	}
+
+	return refreshed
+}
+
+// resourceServerInlineReconcile creates, updates and deletes objects to match the blocks.
+// The identifiers are excluded from the set hashes, which means that only unchanged blocks retain their identifiers.
+// Changed blocks are paired with the objects, whose blocks are gone, by the values of the pair keys (in order of precedence)
+// in order to update the objects in place. The remaining objects are deleted and the remaining blocks are created.
+// The update function is only invoked when pair keys are specified.
+// The caller must hold the lock for the server.
+func resourceServerInlineReconcile(d *schema.ResourceData, key string, pairKeys []string, create func(block map[string]interface{}) (map[string]interface{}, error), update func(id string, block map[string]interface{}) (map[string]interface{}, error), remove func(id string) error) error {
+	o, n := d.GetChange(key)
+	oldBlocks := o.(*schema.Set).List()
+	newBlocks := n.(*schema.Set).List()
+
+	result := make([]interface{}, 0, len(newBlocks))
+	added := make([]map[string]interface{}, 0, len(newBlocks))
+	kept := map[string]bool{}
+
+	for _, v := range newBlocks {
+		block := v.(map[string]interface{})
+		id, _ := block[resourceServerInlineIDKey].(string)
+
+		if len(id) > 0 {
+			kept[id] = true
+			result = append(result, block)
+		} else {
+			added = append(added, block)
+		}
+	}
+
+	removed := make([]map[string]interface{}, 0, len(oldBlocks))
+	remaining := map[string]interface{}{}
+
+	for _, v := range oldBlocks {
+		block := v.(map[string]interface{})
+		id := block[resourceServerInlineIDKey].(string)
+
+		if !kept[id] {
+			removed = append(removed, block)
+			remaining[id] = block
+		}
+	}
+
+	// The state must include every object, which still exists, in case one of the operations fails.
+	fail := func(err error) error {
+		for _, v := range remaining {
+			result = append(result, v)
+		}
+
+		d.Set(key, result)
+
+		return err
+	}
+
+	for _, k := range pairKeys {
+		for i := 0; i < len(added); {
+			j := 0
+
+			for j < len(removed) && removed[j][k] != added[i][k] {
+				j++
+			}
+
+			if j == len(removed) {
+				i++
+
+				continue
+			}
+
+			id := removed[j][resourceServerInlineIDKey].(string)
+			updated, err := update(id, added[i])
+
+			if err != nil {
+				return fail(err)
+			}
+
+			delete(remaining, id)
+			result = append(result, updated)
+
+			added = append(added[:i], added[i+1:]...)
+			removed = append(removed[:j], removed[j+1:]...)
+		}
+	}
+
+	for _, v := range removed {
+		id := v[resourceServerInlineIDKey].(string)
+		err := remove(id)
+
+		if err != nil {
+			return fail(err)
+		}
+
+		delete(remaining, id)
+	}
+
+	for _, v := range added {
+		created, err := create(v)
+
+		if err != nil {
+			return fail(err)
+		}
+
+		result = append(result, created)
+	}
+
+	d.Set(key, result)
+
+	return nil
+}
+
+// resourceServerInlineReconcileDisks reconciles the inline disk blocks.
+func resourceServerInlineReconcileDisks(d *schema.ResourceData, m interface{}, serverID string) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	submit := func(method string, path string, block map[string]interface{}) (map[string]interface{}, error) {
+		body := clouddk.DiskCreateBody{
+			Label: block[dataSourceDiskLabelKey].(string),
+			Size:  clouddk.CustomInt(block[dataSourceDiskSizeKey].(int)),
+		}
+
+		reqBody := new(bytes.Buffer)
+		err := json.NewEncoder(reqBody).Encode(body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := clouddk.DoClientRequest(&clientSettings, method, path, reqBody, []int{200}, 60, 10)
+
+		if err != nil {
+			return nil, err
+		}
+
+		disk := clouddk.DiskBody{}
+		err = json.NewDecoder(res.Body).Decode(&disk)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The next operation will fail unless the transaction for this operation has been completed.
+		err = resourceServerWaitForTransactions(m, serverID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return resourceServerInlineFlattenDisk(&disk), nil
+	}
+
+	// Disks are paired by label in order to resize them rather than replace them.
+	return resourceServerInlineReconcile(
+		d,
+		resourceServerDiskKey,
+		[]string{dataSourceDiskLabelKey},
+		func(block map[string]interface{}) (map[string]interface{}, error) {
+			return submit("POST", fmt.Sprintf("cloudservers/%s/disks", serverID), block)
+		},
+		func(id string, block map[string]interface{}) (map[string]interface{}, error) {
+			return submit("PUT", fmt.Sprintf("cloudservers/%s/disks/%s", serverID, id), block)
+		},
+		func(id string) error {
+			_, err := clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s/disks/%s", serverID, id), new(bytes.Buffer), []int{200, 404}, 60, 10)
+
+			if err != nil {
+				return err
+			}
+
+			return resourceServerWaitForTransactions(m, serverID)
+		},
+	)
+}
+
+// resourceServerInlineReconcileFirewallRules reconciles the inline firewall rule blocks for the primary network interface.
+func resourceServerInlineReconcileFirewallRules(d *schema.ResourceData, m interface{}, server *clouddk.ServerBody) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	networkInterfaceID := ""
+
+	for _, v := range server.NetworkInterfaces {
+		if v.Primary {
+			networkInterfaceID = v.Identifier
+
+			break
+		}
+	}
+
+	if len(networkInterfaceID) == 0 {
+		if d.Get(resourceServerFirewallRuleKey).(*schema.Set).Len() == 0 {
+			return nil
+		}
+
+		return fmt.Errorf("Failed to configure the firewall rules - Reason: The server (id: %s) has no primary network interface", server.Identifier)
+	}
+
+	path := fmt.Sprintf("cloudservers/%s/network-interfaces/%s/firewall-rules", server.Identifier, networkInterfaceID)
+
+	create := func(block map[string]interface{}) (map[string]interface{}, error) {
+		body, err := resourceFirewallRuleBody(
+			block[dataSourceFirewallRuleAddressKey].(string),
+			block[dataSourceFirewallRuleCommandKey].(string),
+			block[dataSourceFirewallRulePortKey].(string),
+			block[dataSourceFirewallRuleProtocolKey].(string),
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		reqBody := new(bytes.Buffer)
+		err = json.NewEncoder(reqBody).Encode(body)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := clouddk.DoClientRequest(&clientSettings, "POST", path, reqBody, []int{200}, 60, 10)
+
+		if err != nil {
+			return nil, err
+		}
+
+		firewallRule := clouddk.FirewallRuleBody{}
+		err = json.NewDecoder(res.Body).Decode(&firewallRule)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return resourceServerInlineFlattenFirewallRule(&firewallRule), nil
+	}
+
+	// Firewall rules have no natural key, which is why changed rules are always replaced rather than updated.
+	return resourceServerInlineReconcile(
+		d,
+		resourceServerFirewallRuleKey,
+		nil,
+		create,
+		nil,
+		func(id string) error {
+			_, err := clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("%s/%s", path, id), new(bytes.Buffer), []int{200, 404}, 60, 10)
+
+			return err
+		},
+	)
+}
@@ -0,0 +1,207 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// TestResourceServerInlineDiskElem tests the schema for the inline disk blocks.
+func TestResourceServerInlineDiskElem(t *testing.T) {
+	s := resourceServerInlineDiskElem()
+
+	requiredKeys := []string{
+		dataSourceDiskLabelKey,
+		dataSourceDiskSizeKey,
+	}
+
+	for _, v := range requiredKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourceServerInlineDiskElem.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Required != true {
+			t.Fatalf("Error in resourceServerInlineDiskElem.Schema: Argument \"%s\" is not required", v)
+		}
+	}
+
+	if s.Schema[dataSourceDiskIDKey] == nil || s.Schema[dataSourceDiskIDKey].Computed != true {
+		t.Fatalf("Error in resourceServerInlineDiskElem.Schema: Attribute \"%s\" is not computed", dataSourceDiskIDKey)
+	}
+}
+
+// TestResourceServerInlineFirewallRuleElem tests the schema for the inline firewall rule blocks.
+func TestResourceServerInlineFirewallRuleElem(t *testing.T) {
+	s := resourceServerInlineFirewallRuleElem()
+
+	requiredKeys := []string{
+		dataSourceFirewallRuleAddressKey,
+		dataSourceFirewallRuleCommandKey,
+		dataSourceFirewallRulePortKey,
+		dataSourceFirewallRuleProtocolKey,
+	}
+
+	for _, v := range requiredKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourceServerInlineFirewallRuleElem.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Required != true {
+			t.Fatalf("Error in resourceServerInlineFirewallRuleElem.Schema: Argument \"%s\" is not required", v)
+		}
+	}
+
+	if s.Schema[dataSourceFirewallRuleIDKey] == nil || s.Schema[dataSourceFirewallRuleIDKey].Computed != true {
+		t.Fatalf("Error in resourceServerInlineFirewallRuleElem.Schema: Attribute \"%s\" is not computed", dataSourceFirewallRuleIDKey)
+	}
+}
+
+// TestResourceServerInlineRefresh tests whether blocks are refreshed and removed when the objects no longer exist.
+func TestResourceServerInlineRefresh(t *testing.T) {
+	blocks := []interface{}{
+		map[string]interface{}{"id": "1", "label": "data", "size": 10},
+		map[string]interface{}{"id": "2", "label": "logs", "size": 10},
+	}
+
+	objects := map[string]map[string]interface{}{
+		"2": {"id": "2", "label": "logs", "size": 20},
+		"3": {"id": "3", "label": "other", "size": 30},
+	}
+
+	refreshed := resourceServerInlineRefresh(blocks, objects)
+
+	if len(refreshed) != 1 {
+		t.Fatalf("Expected 1 block but got %d", len(refreshed))
+	}
+
+	block := refreshed[0].(map[string]interface{})
+
+	if block["id"] != "2" || block["size"] != 20 {
+		t.Fatalf("Unexpected block %v", block)
+	}
+}
+
+// resourceServerInlineReconcileTest records the operations performed by resourceServerInlineReconcile.
+type resourceServerInlineReconcileTest struct {
+	created []string
+	removed []string
+	updated []string
+}
+
+// apply reconciles the inline disk blocks from the current blocks to the configured blocks and returns the resulting blocks.
+func (r *resourceServerInlineReconcileTest) apply(t *testing.T, current []interface{}, config []interface{}) []interface{} {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			resourceServerDiskKey: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     resourceServerInlineDiskElem(),
+			},
+		},
+	}
+
+	resource.Update = func(d *schema.ResourceData, m interface{}) error {
+		return resourceServerInlineReconcile(
+			d,
+			resourceServerDiskKey,
+			[]string{dataSourceDiskLabelKey},
+			func(block map[string]interface{}) (map[string]interface{}, error) {
+				id := fmt.Sprintf("new-%d", len(r.created)+1)
+				r.created = append(r.created, id)
+
+				return map[string]interface{}{dataSourceDiskIDKey: id, dataSourceDiskLabelKey: block[dataSourceDiskLabelKey], dataSourceDiskSizeKey: block[dataSourceDiskSizeKey]}, nil
+			},
+			func(id string, block map[string]interface{}) (map[string]interface{}, error) {
+				r.updated = append(r.updated, id)
+
+				return map[string]interface{}{dataSourceDiskIDKey: id, dataSourceDiskLabelKey: block[dataSourceDiskLabelKey], dataSourceDiskSizeKey: block[dataSourceDiskSizeKey]}, nil
+			},
+			func(id string) error {
+				r.removed = append(r.removed, id)
+
+				return nil
+			},
+		)
+	}
+
+	d := resource.TestResourceData()
+	d.SetId("server")
+	d.Set(resourceServerDiskKey, current)
+
+	state := d.State()
+	diff, err := resource.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{resourceServerDiskKey: config}), nil)
+
+	if err != nil {
+		t.Fatalf("Cannot compute the diff: %s", err.Error())
+	}
+
+	state, err = resource.Apply(state, diff, nil)
+
+	if err != nil {
+		t.Fatalf("Cannot apply the diff: %s", err.Error())
+	}
+
+	return resource.Data(state).Get(resourceServerDiskKey).(*schema.Set).List()
+}
+
+// TestResourceServerInlineReconcileRemoveFirst tests whether removing the first of two disks only deletes the first disk.
+func TestResourceServerInlineReconcileRemoveFirst(t *testing.T) {
+	r := &resourceServerInlineReconcileTest{}
+	blocks := r.apply(
+		t,
+		[]interface{}{
+			map[string]interface{}{dataSourceDiskIDKey: "1", dataSourceDiskLabelKey: "data", dataSourceDiskSizeKey: 10},
+			map[string]interface{}{dataSourceDiskIDKey: "2", dataSourceDiskLabelKey: "logs", dataSourceDiskSizeKey: 20},
+		},
+		[]interface{}{
+			map[string]interface{}{dataSourceDiskLabelKey: "logs", dataSourceDiskSizeKey: 20},
+		},
+	)
+
+	if len(r.removed) != 1 || r.removed[0] != "1" {
+		t.Fatalf("Expected disk 1 to be deleted but the deleted disks are %v", r.removed)
+	}
+
+	if len(r.created) != 0 || len(r.updated) != 0 {
+		t.Fatalf("Expected no disks to be created or updated but got %v and %v", r.created, r.updated)
+	}
+
+	if len(blocks) != 1 || blocks[0].(map[string]interface{})[dataSourceDiskIDKey] != "2" {
+		t.Fatalf("Expected only disk 2 to remain but got %v", blocks)
+	}
+}
+
+// TestResourceServerInlineReconcileChange tests whether changed disks are updated in place and new disks are created.
+func TestResourceServerInlineReconcileChange(t *testing.T) {
+	r := &resourceServerInlineReconcileTest{}
+	blocks := r.apply(
+		t,
+		[]interface{}{
+			map[string]interface{}{dataSourceDiskIDKey: "1", dataSourceDiskLabelKey: "data", dataSourceDiskSizeKey: 10},
+			map[string]interface{}{dataSourceDiskIDKey: "2", dataSourceDiskLabelKey: "logs", dataSourceDiskSizeKey: 20},
+		},
+		[]interface{}{
+			map[string]interface{}{dataSourceDiskLabelKey: "backup", dataSourceDiskSizeKey: 30},
+			map[string]interface{}{dataSourceDiskLabelKey: "data", dataSourceDiskSizeKey: 10},
+			map[string]interface{}{dataSourceDiskLabelKey: "logs", dataSourceDiskSizeKey: 40},
+		},
+	)
+
+	if len(r.removed) != 0 {
+		t.Fatalf("Expected no disks to be deleted but the deleted disks are %v", r.removed)
+	}
+
+	if len(r.updated) != 1 || r.updated[0] != "2" {
+		t.Fatalf("Expected disk 2 to be updated but the updated disks are %v", r.updated)
+	}
+
+	if len(r.created) != 1 || len(blocks) != 3 {
+		t.Fatalf("Expected one disk to be created and three disks to remain but got %v and %v", r.created, blocks)
+	}
+}
@@ -0,0 +1,168 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	resourceServerSnapshotCreatedAtKey = "created_at"
+	resourceServerSnapshotLabelKey     = "label"
+	resourceServerSnapshotServerIDKey  = "server_id"
+)
+
+// resourceServerSnapshot manages a server snapshot.
+func resourceServerSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			resourceServerSnapshotCreatedAtKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation timestamp",
+			},
+			resourceServerSnapshotLabelKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The snapshot label",
+				ForceNew:    true,
+			},
+			resourceServerSnapshotServerIDKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The server identifier",
+				ForceNew:    true,
+			},
+		},
+
+		Create: resourceServerSnapshotCreate,
+		Read:   resourceServerSnapshotRead,
+		Delete: resourceServerSnapshotDelete,
+	}
+}
+
+// resourceServerSnapshotCreate creates a server snapshot.
+func resourceServerSnapshotCreate(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	serverID := d.Get(resourceServerSnapshotServerIDKey).(string)
+
+	body := clouddk.SnapshotCreateBody{
+		Label: d.Get(resourceServerSnapshotLabelKey).(string),
+	}
+
+	reqBody := new(bytes.Buffer)
+	err := json.NewEncoder(reqBody).Encode(body)
+
+	if err != nil {
+		return err
+	}
+
+	snapshot := clouddk.SnapshotBody{}
+
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	err = resourceServerWithLock(m, serverID, "create snapshot", func() error {
+		res, err := clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/snapshots", serverID), reqBody, []int{200}, 60, 10)
+
+		if err != nil {
+			return err
+		}
+
+		err = json.NewDecoder(res.Body).Decode(&snapshot)
+
+		if err != nil {
+			return err
+		}
+
+		// The identifier must be stored before waiting as the snapshot would otherwise be orphaned, if the transaction fails.
+		d.SetId(snapshot.Identifier)
+
+		// The snapshot is not usable until the transaction has been completed.
+		return resourceServerWaitForTransactions(m, serverID)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return resourceServerSnapshotReadResponseBody(d, m, &snapshot)
+}
+
+// resourceServerSnapshotRead reads information about an existing server snapshot.
+func resourceServerSnapshotRead(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	snapshotID := d.Id()
+	serverID := d.Get(resourceServerSnapshotServerIDKey).(string)
+
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("cloudservers/%s/snapshots/%s", serverID, snapshotID), new(bytes.Buffer))
+
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return err
+	} else if res.StatusCode != 200 {
+		if res.StatusCode == 404 {
+			d.SetId("")
+
+			return nil
+		}
+
+		return fmt.Errorf("Failed to read the snapshot information - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	snapshot := clouddk.SnapshotBody{}
+	err = json.NewDecoder(res.Body).Decode(&snapshot)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceServerSnapshotReadResponseBody(d, m, &snapshot)
+}
+
+// resourceServerSnapshotReadResponseBody parses information about a server snapshot.
+func resourceServerSnapshotReadResponseBody(d *schema.ResourceData, m interface{}, snapshot *clouddk.SnapshotBody) error {
+	d.SetId(snapshot.Identifier)
+
+	d.Set(resourceServerSnapshotCreatedAtKey, snapshot.CreatedAt)
+	d.Set(resourceServerSnapshotLabelKey, snapshot.Label)
+
+	return nil
+}
+
+// resourceServerSnapshotDelete deletes an existing server snapshot.
+func resourceServerSnapshotDelete(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	snapshotID := d.Id()
+	serverID := d.Get(resourceServerSnapshotServerIDKey).(string)
+
+	// We need to acquire the lock for the server to reduce the risk of race conditions.
+	err := resourceServerWithLock(m, serverID, "delete snapshot", func() error {
+		_, err := clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("cloudservers/%s/snapshots/%s", serverID, snapshotID), new(bytes.Buffer), []int{200, 404}, 60, 10)
+
+		return err
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,52 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+)
+
+// TestResourceServerSnapshotInstantiation tests whether the resourceServerSnapshot instance can be instantiated.
+func TestResourceServerSnapshotInstantiation(t *testing.T) {
+	s := resourceServerSnapshot()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceServerSnapshot")
+	}
+}
+
+// TestResourceServerSnapshotSchema tests the resourceServerSnapshot schema.
+func TestResourceServerSnapshotSchema(t *testing.T) {
+	s := resourceServerSnapshot()
+
+	requiredKeys := []string{
+		resourceServerSnapshotLabelKey,
+		resourceServerSnapshotServerIDKey,
+	}
+
+	for _, v := range requiredKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourceServerSnapshot.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Required != true {
+			t.Fatalf("Error in resourceServerSnapshot.Schema: Argument \"%s\" is not required", v)
+		}
+	}
+
+	attributeKeys := []string{
+		resourceServerSnapshotCreatedAtKey,
+	}
+
+	for _, v := range attributeKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourceServerSnapshot.Schema: Missing attribute \"%s\"", v)
+		}
+
+		if s.Schema[v].Computed != true {
+			t.Fatalf("Error in resourceServerSnapshot.Schema: Attribute \"%s\" is not computed", v)
+		}
+	}
+}
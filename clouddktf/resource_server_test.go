@@ -5,7 +5,12 @@
 package clouddktf
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
 )
 
 // TestResourceServerInstantiation tests whether the resourceServer instance can be instantiated.
@@ -26,8 +31,6 @@ func TestResourceServerSchema(t *testing.T) {
 		resourceServerLabelKey,
 		resourceServerLocationIDKey,
 		resourceServerPackageIDKey,
-		resourceServerRootPasswordKey,
-		resourceServerTemplateIDKey,
 	}
 
 	for _, v := range requiredKeys {
@@ -41,8 +44,20 @@ func TestResourceServerSchema(t *testing.T) {
 	}
 
 	optionalKeys := []string{
+		resourceServerDeletionProtectionKey,
+		resourceServerDiskKey,
+		resourceServerFirewallRuleKey,
+		resourceServerGracefulShutdownKey,
 		resourceServerPrimaryNetworkInterfaceDefaultFirewallRuleKey,
 		resourceServerPrimaryNetworkInterfaceLabelKey,
+		resourceServerPrimaryNetworkInterfaceRateLimitKey,
+		resourceServerRootPasswordKey,
+		resourceServerSnapshotIDKey,
+		resourceServerSSHKeyIDsKey,
+		resourceServerSSHPublicKeysKey,
+		resourceServerTemplateIDKey,
+		resourceServerUserDataKey,
+		resourceServerWaitForSSHKey,
 	}
 
 	for _, v := range optionalKeys {
@@ -62,6 +77,8 @@ func TestResourceServerSchema(t *testing.T) {
 		dataSourceServerDiskLabelsKey,
 		dataSourceServerDiskPrimaryKey,
 		dataSourceServerDiskSizesKey,
+		dataSourceServerDisksKey,
+		dataSourceServerLocationNameKey,
 		dataSourceServerMemoryKey,
 		dataSourceServerNetworkInterfaceAddressesKey,
 		dataSourceServerNetworkInterfaceDefaultFirewallRulesKey,
@@ -77,8 +94,9 @@ func TestResourceServerSchema(t *testing.T) {
 		dataSourceServerNetworkInterfaceNetworksKey,
 		dataSourceServerNetworkInterfacePrimaryKey,
 		dataSourceServerNetworkInterfaceRateLimitsKey,
-		dataSourceServerLocationNameKey,
+		dataSourceServerNetworkInterfacesKey,
 		dataSourceServerPackageNameKey,
+		dataSourceServerPrimaryIPv4AddressKey,
 		dataSourceServerTemplateNameKey,
 	}
 
@@ -92,3 +110,139 @@ func TestResourceServerSchema(t *testing.T) {
 		}
 	}
 }
+
+// TestResourceServerValidateSSHPublicKeys tests the resourceServerValidateSSHPublicKeys function.
+func TestResourceServerValidateSSHPublicKeys(t *testing.T) {
+	valid := []string{
+		"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIESesvAe4bOUAUe7kPysoah/JJkw8g5rBmKPRsdgdXDu test@example",
+	}
+
+	if err := resourceServerValidateSSHPublicKeys(valid); err != nil {
+		t.Fatalf("Error in resourceServerValidateSSHPublicKeys: %s", err.Error())
+	}
+
+	invalid := []string{
+		"",
+		"ssh-ed25519 invalid",
+	}
+
+	for _, v := range invalid {
+		if resourceServerValidateSSHPublicKeys([]string{v}) == nil {
+			t.Fatalf("Error in resourceServerValidateSSHPublicKeys: Invalid key \"%s\" is accepted", v)
+		}
+	}
+}
+
+// TestResourceServerGeneratePassword tests the resourceServerGeneratePassword function.
+func TestResourceServerGeneratePassword(t *testing.T) {
+	password, err := resourceServerGeneratePassword(resourceServerGeneratedPasswordLength)
+
+	if err != nil {
+		t.Fatalf("Error in resourceServerGeneratePassword: %s", err.Error())
+	}
+
+	if len(password) != resourceServerGeneratedPasswordLength {
+		t.Fatalf("Error in resourceServerGeneratePassword: Expected %d characters but got %d", resourceServerGeneratedPasswordLength, len(password))
+	}
+
+	for _, v := range resourceServerPasswordCharacterClasses {
+		if !strings.ContainsAny(password, v) {
+			t.Fatalf("Error in resourceServerGeneratePassword: Password is missing a character from the class \"%s\"", v)
+		}
+	}
+
+	if _, err := resourceServerGeneratePassword(len(resourceServerPasswordCharacterClasses) - 1); err == nil {
+		t.Fatalf("Error in resourceServerGeneratePassword: Short password length is accepted")
+	}
+}
+
+// TestResourceServerSetConnInfo tests the resourceServerSetConnInfo function.
+func TestResourceServerSetConnInfo(t *testing.T) {
+	d := resourceServer().TestResourceData()
+	d.Set(resourceServerRootPasswordKey, "password")
+
+	server := clouddk.ServerBody{
+		NetworkInterfaces: clouddk.NetworkInterfaceListBody{
+			{
+				Primary:     false,
+				IPAddresses: clouddk.IPAddressListBody{{Address: "10.0.0.10"}},
+			},
+			{
+				Primary:     true,
+				IPAddresses: clouddk.IPAddressListBody{{Address: "2001:db8::10"}, {Address: "192.0.2.10"}},
+			},
+		},
+	}
+
+	resourceServerSetConnInfo(d, &server)
+
+	connInfo := d.ConnInfo()
+
+	if connInfo["host"] != "192.0.2.10" {
+		t.Fatalf("Error in resourceServerSetConnInfo: Expected host \"192.0.2.10\" but got \"%s\"", connInfo["host"])
+	}
+
+	if connInfo["user"] != "root" || connInfo["password"] != "password" {
+		t.Fatalf("Error in resourceServerSetConnInfo: Unexpected credentials %v", connInfo)
+	}
+}
+
+// TestResourceServerNetworkInterfaceRateLimit tests whether an explicit rate limit of zero is distinguished from an unset rate limit.
+func TestResourceServerNetworkInterfaceRateLimit(t *testing.T) {
+	networkInterface := clouddk.NetworkInterfaceBody{Identifier: "example", RateLimit: 100}
+
+	d := resourceServer().TestResourceData()
+	rateLimit, err := resourceServerNetworkInterfaceRateLimit(d, resourceServerPrimaryNetworkInterfaceRateLimitKey, &networkInterface)
+
+	if err != nil {
+		t.Fatalf("Error in resourceServerNetworkInterfaceRateLimit: %s", err.Error())
+	} else if rateLimit != 100 {
+		t.Fatalf("Error in resourceServerNetworkInterfaceRateLimit: Expected the current rate limit 100 but got %d", rateLimit)
+	}
+
+	d.Set(resourceServerPrimaryNetworkInterfaceRateLimitKey, 0)
+	rateLimit, err = resourceServerNetworkInterfaceRateLimit(d, resourceServerPrimaryNetworkInterfaceRateLimitKey, &networkInterface)
+
+	if err != nil {
+		t.Fatalf("Error in resourceServerNetworkInterfaceRateLimit: %s", err.Error())
+	} else if rateLimit != 0 {
+		t.Fatalf("Error in resourceServerNetworkInterfaceRateLimit: Expected the explicit rate limit 0 but got %d", rateLimit)
+	}
+
+	body, err := json.Marshal(clouddk.NetworkInterfaceUpdateBody{RateLimit: rateLimit})
+
+	if err != nil {
+		t.Fatalf("Cannot encode the network interface update body: %s", err.Error())
+	} else if !strings.Contains(string(body), `"rate_limit":0`) {
+		t.Fatalf("Error in NetworkInterfaceUpdateBody: The rate limit 0 is omitted from %s", string(body))
+	}
+}
+
+// TestResourceServerWaitForSSHSettings tests whether invalid wait_for_ssh blocks are rejected.
+func TestResourceServerWaitForSSHSettings(t *testing.T) {
+	_, _, wait, err := resourceServerWaitForSSHSettings(nil)
+
+	if err != nil || wait {
+		t.Fatalf("Error in resourceServerWaitForSSHSettings: Expected no wait without a block")
+	}
+
+	port, timeout, wait, err := resourceServerWaitForSSHSettings([]interface{}{
+		map[string]interface{}{resourceServerWaitForSSHPortKey: 2222, resourceServerWaitForSSHTimeoutKey: 60},
+	})
+
+	if err != nil || !wait || port != 2222 || timeout != time.Minute {
+		t.Fatalf("Error in resourceServerWaitForSSHSettings: Unexpected result for a valid block (port: %d - timeout: %s - error: %v)", port, timeout, err)
+	}
+
+	invalid := []map[string]interface{}{
+		{resourceServerWaitForSSHPortKey: 0, resourceServerWaitForSSHTimeoutKey: 60},
+		{resourceServerWaitForSSHPortKey: 65536, resourceServerWaitForSSHTimeoutKey: 60},
+		{resourceServerWaitForSSHPortKey: 22, resourceServerWaitForSSHTimeoutKey: 0},
+	}
+
+	for _, v := range invalid {
+		if _, _, _, err := resourceServerWaitForSSHSettings([]interface{}{v}); err == nil {
+			t.Fatalf("Error in resourceServerWaitForSSHSettings: Invalid block %v is accepted", v)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	resourceSSHKeyFingerprintKey = "fingerprint"
+	resourceSSHKeyNameKey        = "name"
+	resourceSSHKeyPublicKeyKey   = "public_key"
+)
+
+// resourceSSHKey manages an SSH key.
+func resourceSSHKey() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			resourceSSHKeyFingerprintKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 fingerprint of the public key",
+			},
+			resourceSSHKeyNameKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The SSH key name",
+			},
+			resourceSSHKeyPublicKeyKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The public key in the authorized_keys format",
+				ForceNew:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return resourceSSHKeyEqual(old, new)
+				},
+			},
+		},
+
+		Create: resourceSSHKeyCreate,
+		Read:   resourceSSHKeyRead,
+		Update: resourceSSHKeyUpdate,
+		Delete: resourceSSHKeyDelete,
+
+		CustomizeDiff: resourceSSHKeyCustomizeDiff,
+	}
+}
+
+// resourceSSHKeyCustomizeDiff computes the fingerprint for the planned public key.
+func resourceSSHKeyCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown(resourceSSHKeyPublicKeyKey) {
+		return d.SetNewComputed(resourceSSHKeyFingerprintKey)
+	}
+
+	fingerprint, err := resourceSSHKeyFingerprint(d.Get(resourceSSHKeyPublicKeyKey).(string))
+
+	if err != nil {
+		return err
+	}
+
+	if d.Get(resourceSSHKeyFingerprintKey).(string) != fingerprint {
+		return d.SetNew(resourceSSHKeyFingerprintKey, fingerprint)
+	}
+
+	return nil
+}
+
+// resourceSSHKeyCreate creates an SSH key.
+func resourceSSHKeyCreate(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	body := clouddk.SSHKeyCreateBody{
+		Name:      d.Get(resourceSSHKeyNameKey).(string),
+		PublicKey: d.Get(resourceSSHKeyPublicKeyKey).(string),
+	}
+
+	reqBody := new(bytes.Buffer)
+	err := json.NewEncoder(reqBody).Encode(body)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := clouddk.DoClientRequest(&clientSettings, "POST", "ssh-keys", reqBody, []int{200}, 60, 10)
+
+	if err != nil {
+		return err
+	}
+
+	sshKey := clouddk.SSHKeyBody{}
+	err = json.NewDecoder(res.Body).Decode(&sshKey)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceSSHKeyReadResponseBody(d, m, &sshKey)
+}
+
+// resourceSSHKeyRead reads information about an existing SSH key.
+func resourceSSHKeyRead(d *schema.ResourceData, m interface{}) error {
+	sshKey, err := resourceSSHKeyGet(m, d.Id())
+
+	if err != nil {
+		return err
+	} else if sshKey == nil {
+		d.SetId("")
+
+		return nil
+	}
+
+	return resourceSSHKeyReadResponseBody(d, m, sshKey)
+}
+
+// resourceSSHKeyReadResponseBody parses information about an SSH key.
+func resourceSSHKeyReadResponseBody(d *schema.ResourceData, m interface{}, sshKey *clouddk.SSHKeyBody) error {
+	fingerprint, err := resourceSSHKeyFingerprint(sshKey.PublicKey)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(sshKey.Identifier)
+
+	d.Set(resourceSSHKeyFingerprintKey, fingerprint)
+	d.Set(resourceSSHKeyNameKey, sshKey.Name)
+	d.Set(resourceSSHKeyPublicKeyKey, sshKey.PublicKey)
+
+	return nil
+}
+
+// resourceSSHKeyUpdate updates an existing SSH key.
+func resourceSSHKeyUpdate(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	body := clouddk.SSHKeyUpdateBody{
+		Name: d.Get(resourceSSHKeyNameKey).(string),
+	}
+
+	reqBody := new(bytes.Buffer)
+	err := json.NewEncoder(reqBody).Encode(body)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := clouddk.DoClientRequest(&clientSettings, "PUT", fmt.Sprintf("ssh-keys/%s", d.Id()), reqBody, []int{200}, 60, 10)
+
+	if err != nil {
+		return err
+	}
+
+	sshKey := clouddk.SSHKeyBody{}
+	err = json.NewDecoder(res.Body).Decode(&sshKey)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceSSHKeyReadResponseBody(d, m, &sshKey)
+}
+
+// resourceSSHKeyDelete deletes an existing SSH key.
+func resourceSSHKeyDelete(d *schema.ResourceData, m interface{}) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	_, err := clouddk.DoClientRequest(&clientSettings, "DELETE", fmt.Sprintf("ssh-keys/%s", d.Id()), new(bytes.Buffer), []int{200, 404}, 60, 10)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceSSHKeyEqual determines whether two public keys are identical, ignoring comments and whitespace.
+func resourceSSHKeyEqual(a string, b string) bool {
+	fingerprintA, err := resourceSSHKeyFingerprint(a)
+
+	if err != nil {
+		return false
+	}
+
+	fingerprintB, err := resourceSSHKeyFingerprint(b)
+
+	if err != nil {
+		return false
+	}
+
+	return fingerprintA == fingerprintB
+}
+
+// resourceSSHKeyFingerprint computes the SHA256 fingerprint of a public key in the authorized_keys format.
+func resourceSSHKeyFingerprint(publicKey string) (string, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+
+	if err != nil {
+		return "", fmt.Errorf("Invalid SSH public key - Reason: %s", err.Error())
+	}
+
+	return ssh.FingerprintSHA256(key), nil
+}
+
+// resourceSSHKeyGet retrieves an SSH key and returns nil, if the key does not exist.
+func resourceSSHKeyGet(m interface{}, sshKeyID string) (*clouddk.SSHKeyBody, error) {
+	clientSettings := m.(clouddk.ClientSettings)
+	req, err := clouddk.GetClientRequestObject(&clientSettings, "GET", fmt.Sprintf("ssh-keys/%s", sshKeyID), new(bytes.Buffer))
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{}
+	res, err := client.Do(req)
+
+	if err != nil {
+		return nil, err
+	} else if res.StatusCode != 200 {
+		if res.StatusCode == 404 {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Failed to read the SSH key information - Reason: The API responded with HTTP %s", res.Status)
+	}
+
+	sshKey := clouddk.SSHKeyBody{}
+	err = json.NewDecoder(res.Body).Decode(&sshKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sshKey, nil
+}
@@ -0,0 +1,74 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+)
+
+// TestResourceSSHKeyInstantiation tests whether the resourceSSHKey instance can be instantiated.
+func TestResourceSSHKeyInstantiation(t *testing.T) {
+	s := resourceSSHKey()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceSSHKey")
+	}
+}
+
+// TestResourceSSHKeySchema tests the resourceSSHKey schema.
+func TestResourceSSHKeySchema(t *testing.T) {
+	s := resourceSSHKey()
+
+	requiredKeys := []string{
+		resourceSSHKeyNameKey,
+		resourceSSHKeyPublicKeyKey,
+	}
+
+	for _, v := range requiredKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourceSSHKey.Schema: Missing argument \"%s\"", v)
+		}
+
+		if s.Schema[v].Required != true {
+			t.Fatalf("Error in resourceSSHKey.Schema: Argument \"%s\" is not required", v)
+		}
+	}
+
+	attributeKeys := []string{
+		resourceSSHKeyFingerprintKey,
+	}
+
+	for _, v := range attributeKeys {
+		if s.Schema[v] == nil {
+			t.Fatalf("Error in resourceSSHKey.Schema: Missing attribute \"%s\"", v)
+		}
+
+		if s.Schema[v].Computed != true {
+			t.Fatalf("Error in resourceSSHKey.Schema: Attribute \"%s\" is not computed", v)
+		}
+	}
+}
+
+// TestResourceSSHKeyFingerprint tests the resourceSSHKeyFingerprint and resourceSSHKeyEqual functions.
+func TestResourceSSHKeyFingerprint(t *testing.T) {
+	publicKey := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIESesvAe4bOUAUe7kPysoah/JJkw8g5rBmKPRsdgdXDu test@example"
+	expectedFingerprint := "SHA256:dt1bbX8pDPVv9LXtMxP4q5c+PBTtlbbuqIXLAZWBz3o"
+
+	fingerprint, err := resourceSSHKeyFingerprint(publicKey)
+
+	if err != nil {
+		t.Fatalf("Error in resourceSSHKeyFingerprint: %s", err.Error())
+	} else if fingerprint != expectedFingerprint {
+		t.Fatalf("Error in resourceSSHKeyFingerprint: Expected \"%s\" but got \"%s\"", expectedFingerprint, fingerprint)
+	}
+
+	if _, err := resourceSSHKeyFingerprint("ssh-ed25519 invalid"); err == nil {
+		t.Fatalf("Error in resourceSSHKeyFingerprint: Invalid key is accepted")
+	}
+
+	if !resourceSSHKeyEqual(publicKey, "  ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIESesvAe4bOUAUe7kPysoah/JJkw8g5rBmKPRsdgdXDu other\n") {
+		t.Fatalf("Error in resourceSSHKeyEqual: Keys with different comments are not considered equal")
+	}
+}
@@ -0,0 +1,63 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	serverActionDefaultLimit = 1
+	serverActionSpacing      = 2 * time.Second
+)
+
+// serverActionGate limits the number of concurrent account-global server actions and enforces a minimum delay between them.
+type serverActionGate struct {
+	last    time.Time
+	mutex   sync.Mutex
+	slots   chan struct{}
+	spacing time.Duration
+}
+
+// newServerActionGate returns a new gate which allows up to limit concurrent actions started at least spacing apart.
+func newServerActionGate(limit int, spacing time.Duration) *serverActionGate {
+	if limit < 1 {
+		limit = 1
+	}
+
+	return &serverActionGate{
+		slots:   make(chan struct{}, limit),
+		spacing: spacing,
+	}
+}
+
+// WithServerAction waits for a free slot, invokes fn and releases the slot again.
+func (g *serverActionGate) WithServerAction(operation string, fn func() error) error {
+	timeStart := time.Now()
+
+	g.slots <- struct{}{}
+	defer func() { <-g.slots }()
+
+	g.wait()
+
+	log.Printf("[DEBUG] Starting server action (operation: %s - waited: %s)", operation, time.Since(timeStart).Round(time.Millisecond))
+
+	return fn()
+}
+
+// wait blocks until the minimum delay since the previous action has passed.
+func (g *serverActionGate) wait() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	// The mutex is held while sleeping to ensure that waiting actions are started one at a time.
+	if delay := g.spacing - time.Since(g.last); delay > 0 {
+		time.Sleep(delay)
+	}
+
+	g.last = time.Now()
+}
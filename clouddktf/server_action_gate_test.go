@@ -0,0 +1,85 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestServerActionGateLimit tests whether the serverActionGate limits the number of concurrent actions.
+func TestServerActionGateLimit(t *testing.T) {
+	g := newServerActionGate(2, 0)
+
+	var active int32
+	var maxActive int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			g.WithServerAction("example", func() error {
+				n := atomic.AddInt32(&active, 1)
+
+				for {
+					m := atomic.LoadInt32(&maxActive)
+
+					if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+						break
+					}
+				}
+
+				time.Sleep(20 * time.Millisecond)
+				atomic.AddInt32(&active, -1)
+
+				return nil
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	if maxActive != 2 {
+		t.Fatalf("Expected 2 concurrent actions but got %d", maxActive)
+	}
+}
+
+// TestServerActionGateSpacing tests whether the serverActionGate enforces the minimum delay between actions.
+func TestServerActionGateSpacing(t *testing.T) {
+	g := newServerActionGate(3, 50*time.Millisecond)
+
+	var mutex sync.Mutex
+	var starts []time.Time
+	var wg sync.WaitGroup
+
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			g.WithServerAction("example", func() error {
+				mutex.Lock()
+				starts = append(starts, time.Now())
+				mutex.Unlock()
+
+				return nil
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	for i := 1; i < len(starts); i++ {
+		if delay := starts[i].Sub(starts[i-1]); delay < 45*time.Millisecond {
+			t.Fatalf("Expected actions to be started at least 50ms apart but got %s", delay)
+		}
+	}
+}
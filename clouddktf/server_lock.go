@@ -0,0 +1,180 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	serverLockDefaultTimeout = 15 * time.Minute
+)
+
+// serverLock describes the lock for a single server.
+type serverLock struct {
+	channel   chan struct{}
+	operation string
+	since     time.Time
+}
+
+// serverLockManager manages the locks for servers.
+type serverLockManager struct {
+	backend serverLockBackend
+	locks   map[string]*serverLock
+	mutex   sync.Mutex
+	timeout time.Duration
+}
+
+// newServerLockManager returns a new lock manager which uses the specified backend, if not nil, to coordinate with other processes.
+func newServerLockManager(backend serverLockBackend, timeout time.Duration) *serverLockManager {
+	return &serverLockManager{
+		backend: backend,
+		locks:   make(map[string]*serverLock),
+		timeout: timeout,
+	}
+}
+
+// WithServerLock acquires the lock for a server, invokes fn and releases the lock again.
+func (m *serverLockManager) WithServerLock(ctx context.Context, serverID string, operation string, fn func() error) error {
+	err := m.lock(ctx, serverID, operation)
+
+	if err != nil {
+		return err
+	}
+
+	defer m.unlock(serverID)
+
+	return fn()
+}
+
+// WithServerLocks acquires the locks for multiple servers in a consistent order to avoid deadlocks, invokes fn and releases the locks again.
+func (m *serverLockManager) WithServerLocks(ctx context.Context, serverIDs []string, operation string, fn func() error) error {
+	ids := make([]string, 0, len(serverIDs))
+	seen := make(map[string]bool, len(serverIDs))
+
+	for _, v := range serverIDs {
+		if !seen[v] {
+			ids = append(ids, v)
+			seen[v] = true
+		}
+	}
+
+	sort.Strings(ids)
+
+	for i, v := range ids {
+		err := m.lock(ctx, v, operation)
+
+		if err != nil {
+			for j := i - 1; j >= 0; j-- {
+				m.unlock(ids[j])
+			}
+
+			return err
+		}
+	}
+
+	defer func() {
+		for i := len(ids) - 1; i >= 0; i-- {
+			m.unlock(ids[i])
+		}
+	}()
+
+	return fn()
+}
+
+// get returns the lock for a server and creates it, if it does not already exist.
+func (m *serverLockManager) get(serverID string) *serverLock {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.locks[serverID] == nil {
+		log.Printf("[DEBUG] Creating lock for server (id: %s)", serverID)
+		m.locks[serverID] = &serverLock{channel: make(chan struct{}, 1)}
+	}
+
+	return m.locks[serverID]
+}
+
+// holder returns a description of the operation currently holding the lock for a server.
+func (m *serverLockManager) holder(serverID string) string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	l := m.locks[serverID]
+
+	if l == nil || len(l.operation) < 1 {
+		return "unknown operation"
+	}
+
+	return fmt.Sprintf("'%s' for %s", l.operation, time.Now().Sub(l.since).Round(time.Second))
+}
+
+// lock acquires the lock for a server.
+func (m *serverLockManager) lock(ctx context.Context, serverID string, operation string) error {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	l := m.get(serverID)
+
+	log.Printf("[DEBUG] Acquiring lock for server (id: %s - operation: %s)", serverID, operation)
+
+	select {
+	case l.channel <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("Timeout while waiting for the lock for server (id: %s - operation: %s) - The lock is held by %s", serverID, operation, m.holder(serverID))
+	}
+
+	m.mutex.Lock()
+	l.operation = operation
+	l.since = time.Now()
+	m.mutex.Unlock()
+
+	// Other processes may be operating on the same server, which is why we also need to acquire the lock from the backend, if one has been configured.
+	if m.backend != nil {
+		timeout := m.timeout
+
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout = deadline.Sub(time.Now())
+		}
+
+		err := m.backend.Lock(serverID, timeout)
+
+		if err != nil {
+			m.release(serverID, l)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// release clears the holder information and releases the lock for a server.
+func (m *serverLockManager) release(serverID string, l *serverLock) {
+	m.mutex.Lock()
+	l.operation = ""
+	m.mutex.Unlock()
+
+	log.Printf("[DEBUG] Releasing lock for server (id: %s)", serverID)
+	<-l.channel
+}
+
+// unlock releases the lock for a server.
+func (m *serverLockManager) unlock(serverID string) {
+	if m.backend != nil {
+		err := m.backend.Unlock(serverID)
+
+		if err != nil {
+			log.Printf("[WARN] %s", err.Error())
+		}
+	}
+
+	m.release(serverID, m.get(serverID))
+}
@@ -0,0 +1,269 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	serverLockFileExtension       = ".lock"
+	serverLockFileMaxAge          = 10 * time.Minute
+	serverLockFilePrefix          = "clouddk-server-"
+	serverLockFileRefreshInterval = 1 * time.Minute
+	serverLockFileStaleExtension  = ".stale"
+)
+
+// serverLockBackend describes a backend which coordinates server locks between multiple processes.
+type serverLockBackend interface {
+	Lock(serverID string, timeout time.Duration) error
+	Unlock(serverID string) error
+}
+
+// fileServerLock describes a lock file held by this process.
+type fileServerLock struct {
+	done  chan struct{}
+	owner string
+	stop  chan struct{}
+}
+
+// fileServerLockBackend coordinates server locks by creating lock files in a shared directory.
+type fileServerLockBackend struct {
+	directory       string
+	held            map[string]*fileServerLock
+	maxAge          time.Duration
+	mutex           sync.Mutex
+	owner           string
+	refreshInterval time.Duration
+}
+
+// newFileServerLockBackend returns a new lock backend which stores its lock files in the specified directory.
+func newFileServerLockBackend(directory string) (*fileServerLockBackend, error) {
+	if len(directory) < 1 {
+		return nil, fmt.Errorf("The lock directory cannot be an empty string")
+	}
+
+	err := os.MkdirAll(directory, 0700)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create the lock directory '%s' - Reason: %s", directory, err.Error())
+	}
+
+	hostname, err := os.Hostname()
+
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return &fileServerLockBackend{
+		directory:       directory,
+		held:            make(map[string]*fileServerLock),
+		maxAge:          serverLockFileMaxAge,
+		owner:           fmt.Sprintf("%s %d", hostname, os.Getpid()),
+		refreshInterval: serverLockFileRefreshInterval,
+	}, nil
+}
+
+// Lock acquires the lock file for a specific server.
+func (b *fileServerLockBackend) Lock(serverID string, timeout time.Duration) error {
+	path := b.path(serverID)
+	timeStart := time.Now()
+
+	log.Printf("[DEBUG] Acquiring lock file for server (id: %s - path: %s)", serverID, path)
+
+	for {
+		owner := fmt.Sprintf("%s %d", b.owner, time.Now().UnixNano())
+		acquired, err := b.create(path, owner)
+
+		if err != nil {
+			return fmt.Errorf("Failed to create the lock file for server (id: %s) - Reason: %s", serverID, err.Error())
+		}
+
+		if acquired {
+			l := &fileServerLock{
+				done:  make(chan struct{}),
+				owner: owner,
+				stop:  make(chan struct{}),
+			}
+
+			b.mutex.Lock()
+			b.held[serverID] = l
+			b.mutex.Unlock()
+
+			go b.refresh(path, l)
+
+			return nil
+		}
+
+		// Lock files left behind by processes which were killed must not block us forever.
+		if b.removeStale(serverID, path) {
+			continue
+		}
+
+		if time.Now().Sub(timeStart) > timeout {
+			return fmt.Errorf("Timeout while waiting for the lock file for server (id: %s - path: %s - owner: %s)", serverID, path, b.read(path))
+		}
+
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// Unlock releases the lock file for a specific server.
+func (b *fileServerLockBackend) Unlock(serverID string) error {
+	path := b.path(serverID)
+
+	log.Printf("[DEBUG] Releasing lock file for server (id: %s - path: %s)", serverID, path)
+
+	b.mutex.Lock()
+	l := b.held[serverID]
+	delete(b.held, serverID)
+	b.mutex.Unlock()
+
+	if l == nil {
+		return fmt.Errorf("Failed to remove the lock file for server (id: %s) - Reason: The lock is not held by this process", serverID)
+	}
+
+	close(l.stop)
+	<-l.done
+
+	// The lock file must not be removed, if another process has taken it over.
+	if owner := b.read(path); owner != l.owner {
+		return fmt.Errorf("Failed to remove the lock file for server (id: %s) - Reason: The lock file is owned by '%s'", serverID, owner)
+	}
+
+	err := os.Remove(path)
+
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("Failed to remove the lock file for server (id: %s) - Reason: %s", serverID, err.Error())
+	}
+
+	return nil
+}
+
+// create creates a lock file with the specified owner and returns false, if the file already exists.
+func (b *fileServerLockBackend) create(path string, owner string) (bool, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+
+	if err != nil {
+		if os.IsExist(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	_, err = fmt.Fprintln(file, owner)
+
+	if err != nil {
+		file.Close()
+		os.Remove(path)
+
+		return false, err
+	}
+
+	err = file.Close()
+
+	if err != nil {
+		os.Remove(path)
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// refresh updates the modification time of a lock file until the lock is released to prevent other processes from considering it stale.
+func (b *fileServerLockBackend) refresh(path string, l *fileServerLock) {
+	defer close(l.done)
+
+	ticker := time.NewTicker(b.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			owner := b.read(path)
+
+			// The lock file may briefly be missing, while another process inspects it.
+			if len(owner) == 0 {
+				continue
+			} else if owner != l.owner {
+				log.Printf("[WARN] The lock file '%s' has been taken over by '%s'", path, owner)
+
+				return
+			}
+
+			now := time.Now()
+			err := os.Chtimes(path, now, now)
+
+			if err != nil {
+				log.Printf("[WARN] Failed to refresh the lock file '%s' - Reason: %s", path, err.Error())
+			}
+		}
+	}
+}
+
+// removeStale removes a lock file, which has not been refreshed within the maximum age, and returns whether it was removed.
+func (b *fileServerLockBackend) removeStale(serverID string, path string) bool {
+	info, err := os.Stat(path)
+
+	if err != nil || time.Now().Sub(info.ModTime()) <= b.maxAge {
+		return false
+	}
+
+	owner := b.read(path)
+
+	// Renaming the file first ensures that only one of the waiting processes removes it.
+	stalePath := fmt.Sprintf("%s.%d%s", path, time.Now().UnixNano(), serverLockFileStaleExtension)
+	err = os.Rename(path, stalePath)
+
+	if err != nil {
+		return false
+	}
+
+	defer os.Remove(stalePath)
+
+	// Another process may have replaced the stale lock file after we inspected it, in which case we must put its lock file back.
+	if staleOwner := b.read(stalePath); staleOwner != owner {
+		err = os.Link(stalePath, path)
+
+		if err != nil {
+			log.Printf("[WARN] Failed to restore the lock file for server (id: %s - owner: %s) - Reason: %s", serverID, staleOwner, err.Error())
+		}
+
+		return false
+	}
+
+	log.Printf("[DEBUG] Removed stale lock file for server (id: %s - path: %s - owner: %s)", serverID, path, owner)
+
+	return true
+}
+
+// read returns the owner of a lock file or an empty string, if the file cannot be read.
+func (b *fileServerLockBackend) read(path string) string {
+	data, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(data))
+}
+
+// path returns the path to the lock file for a specific server.
+func (b *fileServerLockBackend) path(serverID string) string {
+	name := strings.NewReplacer("/", "_", "\\", "_", "..", "_").Replace(serverID)
+
+	return filepath.Join(b.directory, serverLockFilePrefix+name+serverLockFileExtension)
+}
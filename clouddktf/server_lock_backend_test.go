@@ -0,0 +1,190 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestFileServerLockBackend tests whether the fileServerLockBackend instance can acquire and release locks.
+func TestFileServerLockBackend(t *testing.T) {
+	directory, err := ioutil.TempDir("", "terraform-provider-clouddk")
+
+	if err != nil {
+		t.Fatalf("Cannot create temporary directory: %s", err.Error())
+	}
+
+	defer os.RemoveAll(directory)
+
+	b, err := newFileServerLockBackend(directory)
+
+	if err != nil {
+		t.Fatalf("Cannot instantiate fileServerLockBackend: %s", err.Error())
+	}
+
+	err = b.Lock("example", time.Minute)
+
+	if err != nil {
+		t.Fatalf("Cannot acquire lock: %s", err.Error())
+	}
+
+	err = b.Lock("example", time.Second)
+
+	if err == nil {
+		t.Fatalf("Acquired a lock which is already held")
+	}
+
+	err = b.Unlock("example")
+
+	if err != nil {
+		t.Fatalf("Cannot release lock: %s", err.Error())
+	}
+
+	err = b.Lock("example", time.Second)
+
+	if err != nil {
+		t.Fatalf("Cannot acquire lock after release: %s", err.Error())
+	}
+
+	b.Unlock("example")
+}
+
+// TestFileServerLockBackendStale tests whether the fileServerLockBackend removes lock files which have not been refreshed.
+func TestFileServerLockBackendStale(t *testing.T) {
+	directory, err := ioutil.TempDir("", "terraform-provider-clouddk")
+
+	if err != nil {
+		t.Fatalf("Cannot create temporary directory: %s", err.Error())
+	}
+
+	defer os.RemoveAll(directory)
+
+	b, err := newFileServerLockBackend(directory)
+
+	if err != nil {
+		t.Fatalf("Cannot instantiate fileServerLockBackend: %s", err.Error())
+	}
+
+	path := b.path("example")
+	err = ioutil.WriteFile(path, []byte("example-host 1 1\n"), 0600)
+
+	if err != nil {
+		t.Fatalf("Cannot create lock file: %s", err.Error())
+	}
+
+	staleTime := time.Now().Add(-2 * serverLockFileMaxAge)
+	err = os.Chtimes(path, staleTime, staleTime)
+
+	if err != nil {
+		t.Fatalf("Cannot change the modification time of the lock file: %s", err.Error())
+	}
+
+	err = b.Lock("example", time.Second)
+
+	if err != nil {
+		t.Fatalf("Cannot acquire lock after the lock file became stale: %s", err.Error())
+	}
+
+	err = b.Unlock("example")
+
+	if err != nil {
+		t.Fatalf("Cannot release lock: %s", err.Error())
+	}
+}
+
+// TestFileServerLockBackendRefresh tests whether a held lock file is refreshed and therefore never considered stale.
+func TestFileServerLockBackendRefresh(t *testing.T) {
+	directory, err := ioutil.TempDir("", "terraform-provider-clouddk")
+
+	if err != nil {
+		t.Fatalf("Cannot create temporary directory: %s", err.Error())
+	}
+
+	defer os.RemoveAll(directory)
+
+	first, err := newFileServerLockBackend(directory)
+
+	if err != nil {
+		t.Fatalf("Cannot instantiate fileServerLockBackend: %s", err.Error())
+	}
+
+	second, err := newFileServerLockBackend(directory)
+
+	if err != nil {
+		t.Fatalf("Cannot instantiate fileServerLockBackend: %s", err.Error())
+	}
+
+	first.refreshInterval = 20 * time.Millisecond
+	second.maxAge = 100 * time.Millisecond
+
+	err = first.Lock("example", time.Second)
+
+	if err != nil {
+		t.Fatalf("Cannot acquire lock: %s", err.Error())
+	}
+
+	err = second.Lock("example", 500*time.Millisecond)
+
+	if err == nil {
+		t.Fatalf("Acquired a lock which is held and refreshed by another process")
+	}
+
+	err = first.Unlock("example")
+
+	if err != nil {
+		t.Fatalf("Cannot release lock: %s", err.Error())
+	}
+
+	err = second.Lock("example", time.Second)
+
+	if err != nil {
+		t.Fatalf("Cannot acquire lock after release: %s", err.Error())
+	}
+
+	second.Unlock("example")
+}
+
+// TestFileServerLockBackendForeignUnlock tests whether the fileServerLockBackend refuses to remove a lock file owned by another process.
+func TestFileServerLockBackendForeignUnlock(t *testing.T) {
+	directory, err := ioutil.TempDir("", "terraform-provider-clouddk")
+
+	if err != nil {
+		t.Fatalf("Cannot create temporary directory: %s", err.Error())
+	}
+
+	defer os.RemoveAll(directory)
+
+	b, err := newFileServerLockBackend(directory)
+
+	if err != nil {
+		t.Fatalf("Cannot instantiate fileServerLockBackend: %s", err.Error())
+	}
+
+	err = b.Lock("example", time.Second)
+
+	if err != nil {
+		t.Fatalf("Cannot acquire lock: %s", err.Error())
+	}
+
+	path := b.path("example")
+	err = ioutil.WriteFile(path, []byte("example-host 1 1\n"), 0600)
+
+	if err != nil {
+		t.Fatalf("Cannot overwrite lock file: %s", err.Error())
+	}
+
+	err = b.Unlock("example")
+
+	if err == nil {
+		t.Fatalf("Removed a lock file owned by another process")
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("The lock file owned by another process was removed: %s", err.Error())
+	}
+}
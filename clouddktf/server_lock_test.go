@@ -0,0 +1,86 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestServerLockManagerRelease tests whether the serverLockManager releases a lock when the function fails.
+func TestServerLockManagerRelease(t *testing.T) {
+	m := newServerLockManager(nil, time.Second)
+	expectedErr := errors.New("example")
+
+	err := m.WithServerLock(context.Background(), "example", "first operation", func() error {
+		return expectedErr
+	})
+
+	if err != expectedErr {
+		t.Fatalf("Unexpected error returned by serverLockManager.WithServerLock: %v", err)
+	}
+
+	err = m.WithServerLock(context.Background(), "example", "second operation", func() error {
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Cannot acquire lock after a failed operation: %s", err.Error())
+	}
+}
+
+// TestServerLockManagerTimeout tests whether the serverLockManager reports the holder of a lock when a wait times out.
+func TestServerLockManagerTimeout(t *testing.T) {
+	m := newServerLockManager(nil, 100*time.Millisecond)
+
+	err := m.WithServerLock(context.Background(), "example", "first operation", func() error {
+		return m.WithServerLock(context.Background(), "example", "second operation", func() error {
+			return nil
+		})
+	})
+
+	if err == nil {
+		t.Fatalf("Acquired a lock which is already held")
+	}
+
+	if !strings.Contains(err.Error(), "first operation") {
+		t.Fatalf("The error does not mention the operation holding the lock: %s", err.Error())
+	}
+}
+
+// TestServerLockManagerMultiple tests whether the serverLockManager can acquire multiple locks in any order without deadlocking.
+func TestServerLockManagerMultiple(t *testing.T) {
+	m := newServerLockManager(nil, time.Second)
+	errs := make(chan error, 2)
+
+	for _, ids := range [][]string{{"a", "b"}, {"b", "a", "b"}} {
+		go func(ids []string) {
+			errs <- m.WithServerLocks(context.Background(), ids, "move", func() error {
+				time.Sleep(10 * time.Millisecond)
+
+				return nil
+			})
+		}(ids)
+	}
+
+	for i := 0; i < 2; i++ {
+		err := <-errs
+
+		if err != nil {
+			t.Fatalf("Cannot acquire multiple locks: %s", err.Error())
+		}
+	}
+
+	err := m.WithServerLock(context.Background(), "a", "verify", func() error {
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Cannot acquire lock after releasing multiple locks: %s", err.Error())
+	}
+}
@@ -0,0 +1,138 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/danitso/terraform-provider-clouddk/clouddk"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	gracefulShutdownKey        = "graceful_shutdown"
+	gracefulShutdownTimeoutKey = "timeout"
+
+	serverShutdownDefaultTimeout = 300
+	serverShutdownPollDelay      = 5 * time.Second
+)
+
+// gracefulShutdownSchema returns the schema for a graceful_shutdown block.
+func gracefulShutdownSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				gracefulShutdownTimeoutKey: {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     serverShutdownDefaultTimeout,
+					Description: "The number of seconds to wait for the server to shut down",
+				},
+			},
+		},
+	}
+}
+
+// gracefulShutdownResource describes the method shared by schema.ResourceData and schema.ResourceDiff, which is needed to read a graceful_shutdown block.
+type gracefulShutdownResource interface {
+	Get(key string) interface{}
+}
+
+// gracefulShutdownCustomizeDiff validates the graceful_shutdown block during the plan phase.
+func gracefulShutdownCustomizeDiff(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown(fmt.Sprintf("%s.0.%s", gracefulShutdownKey, gracefulShutdownTimeoutKey)) {
+		return nil
+	}
+
+	_, _, err := gracefulShutdownTimeout(d)
+
+	return err
+}
+
+// gracefulShutdownTimeout returns the timeout for the graceful_shutdown block and whether the block has been specified.
+func gracefulShutdownTimeout(d gracefulShutdownResource) (time.Duration, bool, error) {
+	gracefulShutdownList := d.Get(gracefulShutdownKey).([]interface{})
+
+	if len(gracefulShutdownList) == 0 || gracefulShutdownList[0] == nil {
+		return 0, false, nil
+	}
+
+	gracefulShutdownBlock := gracefulShutdownList[0].(map[string]interface{})
+	timeout := gracefulShutdownBlock[gracefulShutdownTimeoutKey].(int)
+
+	if timeout < 1 {
+		return 0, false, fmt.Errorf("Invalid shutdown timeout %d (must be greater than zero)", timeout)
+	}
+
+	return time.Duration(timeout) * time.Second, true, nil
+}
+
+// resourceServerShutdown requests an ACPI shutdown and waits for the server to power off.
+// The function returns whether the server was running and the caller must hold the lock for the server.
+func resourceServerShutdown(m interface{}, serverID string, timeout time.Duration) (bool, error) {
+	server, err := resourceServerGet(m, serverID)
+
+	if err != nil {
+		return false, err
+	} else if !server.Booted {
+		return false, nil
+	}
+
+	clientSettings := m.(clouddk.ClientSettings)
+
+	_, err = clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/shutdown", serverID), new(bytes.Buffer), []int{200}, 60, 10)
+
+	if err != nil {
+		return true, err
+	}
+
+	// The boot flag is not cleared until the shutdown transaction has been completed.
+	err = resourceServerWaitForTransactions(m, serverID)
+
+	if err != nil {
+		return true, err
+	}
+
+	timeStart := time.Now()
+
+	for {
+		server, err = resourceServerGet(m, serverID)
+
+		if err != nil {
+			return true, err
+		} else if !server.Booted {
+			log.Printf("[DEBUG] Server has been shut down (id: %s - elapsed: %s)", serverID, time.Since(timeStart).Round(time.Second))
+
+			return true, nil
+		}
+
+		if time.Since(timeStart)+serverShutdownPollDelay > timeout {
+			return true, fmt.Errorf("Timeout while waiting for the server (id: %s) to shut down", serverID)
+		}
+
+		time.Sleep(serverShutdownPollDelay)
+	}
+}
+
+// resourceServerStart starts a server and waits for the transaction to complete.
+// The caller must hold the lock for the server.
+func resourceServerStart(m interface{}, serverID string) error {
+	clientSettings := m.(clouddk.ClientSettings)
+
+	_, err := clouddk.DoClientRequest(&clientSettings, "POST", fmt.Sprintf("cloudservers/%s/start", serverID), new(bytes.Buffer), []int{200}, 60, 10)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceServerWaitForTransactions(m, serverID)
+}
@@ -0,0 +1,87 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// TestGracefulShutdownTimeout tests whether the graceful_shutdown block is parsed correctly.
+func TestGracefulShutdownTimeout(t *testing.T) {
+	d := resourceServer().TestResourceData()
+
+	_, shutdown, err := gracefulShutdownTimeout(d)
+
+	if err != nil {
+		t.Fatalf("Failed to parse an empty graceful_shutdown block - Reason: %s", err.Error())
+	} else if shutdown {
+		t.Fatalf("Expected no graceful shutdown without a graceful_shutdown block")
+	}
+
+	d.Set(resourceServerGracefulShutdownKey, []interface{}{
+		map[string]interface{}{gracefulShutdownTimeoutKey: 120},
+	})
+
+	timeout, shutdown, err := gracefulShutdownTimeout(d)
+
+	if err != nil {
+		t.Fatalf("Failed to parse the graceful_shutdown block - Reason: %s", err.Error())
+	} else if !shutdown {
+		t.Fatalf("Expected a graceful shutdown with a graceful_shutdown block")
+	} else if timeout != 120*time.Second {
+		t.Fatalf("Expected a timeout of 2m0s but got %s", timeout)
+	}
+
+	d.Set(resourceServerGracefulShutdownKey, []interface{}{
+		map[string]interface{}{gracefulShutdownTimeoutKey: 0},
+	})
+
+	_, _, err = gracefulShutdownTimeout(d)
+
+	if err == nil {
+		t.Fatalf("Expected an error for a timeout of zero")
+	}
+}
+
+// TestGracefulShutdownCustomizeDiff tests whether an invalid timeout is rejected during the plan phase.
+func TestGracefulShutdownCustomizeDiff(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			gracefulShutdownKey: gracefulShutdownSchema("The settings for shutting down the server"),
+		},
+		CustomizeDiff: func(d *schema.ResourceDiff, m interface{}) error {
+			return gracefulShutdownCustomizeDiff(d)
+		},
+	}
+
+	tests := []struct {
+		timeout int
+		valid   bool
+	}{
+		{120, true},
+		{0, false},
+		{-1, false},
+	}
+
+	for _, v := range tests {
+		config := map[string]interface{}{
+			gracefulShutdownKey: []interface{}{
+				map[string]interface{}{gracefulShutdownTimeoutKey: v.timeout},
+			},
+		}
+
+		_, err := r.Diff(nil, terraform.NewResourceConfigRaw(config), nil)
+
+		if v.valid && err != nil {
+			t.Fatalf("Timeout %d is rejected - Reason: %s", v.timeout, err.Error())
+		} else if !v.valid && err == nil {
+			t.Fatalf("Invalid timeout %d is accepted", v.timeout)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	serverSSHDialTimeout  = 10 * time.Second
+	serverSSHRetryDelay   = 5 * time.Second
+	serverSSHBannerPrefix = "SSH-"
+)
+
+// waitForSSH waits for an SSH server to accept connections and send its identification banner.
+func waitForSSH(address string, timeout time.Duration, retryDelay time.Duration) error {
+	timeStart := time.Now()
+	attempt := 0
+
+	for {
+		attempt++
+
+		banner, err := readSSHBanner(address)
+
+		if err == nil {
+			log.Printf("[DEBUG] SSH server is ready (address: %s - banner: %s - attempt: %d)", address, banner, attempt)
+
+			return nil
+		}
+
+		elapsed := time.Since(timeStart)
+
+		if elapsed+retryDelay > timeout {
+			return fmt.Errorf("Timeout while waiting for SSH server (address: %s) - Reason: %s", address, err.Error())
+		}
+
+		log.Printf("[DEBUG] SSH server is not ready (address: %s - attempt: %d - elapsed: %s) - Reason: %s", address, attempt, elapsed.Round(time.Second), err.Error())
+
+		time.Sleep(retryDelay)
+	}
+}
+
+// readSSHBanner connects to an SSH server and returns its identification banner.
+func readSSHBanner(address string) (string, error) {
+	conn, err := net.DialTimeout("tcp", address, serverSSHDialTimeout)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer conn.Close()
+
+	err = conn.SetReadDeadline(time.Now().Add(serverSSHDialTimeout))
+
+	if err != nil {
+		return "", err
+	}
+
+	// The server may send other lines before the banner, as permitted by RFC 4253.
+	reader := bufio.NewReader(conn)
+
+	for i := 0; i < 10; i++ {
+		line, err := reader.ReadString('\n')
+
+		if strings.HasPrefix(line, serverSSHBannerPrefix) {
+			return strings.TrimSpace(line), nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return "", fmt.Errorf("The server did not send an SSH banner")
+}
@@ -0,0 +1,77 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package clouddktf
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+// TestWaitForSSH tests whether waitForSSH succeeds once a server sends an SSH banner.
+func TestWaitForSSH(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("Cannot create listener: %s", err.Error())
+	}
+
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+
+			if err != nil {
+				return
+			}
+
+			conn.Write([]byte("Welcome\r\nSSH-2.0-OpenSSH_8.2\r\n"))
+			conn.Close()
+		}
+	}()
+
+	err = waitForSSH(listener.Addr().String(), time.Second, 10*time.Millisecond)
+
+	if err != nil {
+		t.Fatalf("Error in waitForSSH: %s", err.Error())
+	}
+}
+
+// TestWaitForSSHTimeout tests whether waitForSSH times out when no banner is received.
+func TestWaitForSSHTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("Cannot create listener: %s", err.Error())
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+
+			if err != nil {
+				return
+			}
+
+			conn.Write([]byte("HTTP/1.1 400 Bad Request\r\n"))
+			conn.Close()
+		}
+	}()
+
+	address := listener.Addr().String()
+	err = waitForSSH(address, 100*time.Millisecond, 10*time.Millisecond)
+	listener.Close()
+
+	if err == nil {
+		t.Fatalf("Error in waitForSSH: Missing banner is accepted")
+	}
+
+	err = waitForSSH(address, 100*time.Millisecond, 10*time.Millisecond)
+
+	if err == nil {
+		t.Fatalf("Error in waitForSSH: Closed port is accepted")
+	}
+}
@@ -0,0 +1,41 @@
+data "clouddk_account" "example" {}
+
+output "data_clouddk_account_example_balance" {
+  description = "The account balance"
+  value       = "${data.clouddk_account.example.balance}"
+}
+
+output "data_clouddk_account_example_currency" {
+  description = "The currency for the account balance"
+  value       = "${data.clouddk_account.example.currency}"
+}
+
+output "data_clouddk_account_example_disk_limit" {
+  description = "The maximum number of disks"
+  value       = "${data.clouddk_account.example.disk_limit}"
+}
+
+output "data_clouddk_account_example_disk_usage" {
+  description = "The number of disks in use"
+  value       = "${data.clouddk_account.example.disk_usage}"
+}
+
+output "data_clouddk_account_example_ip_address_limit" {
+  description = "The maximum number of public IP addresses"
+  value       = "${data.clouddk_account.example.ip_address_limit}"
+}
+
+output "data_clouddk_account_example_ip_address_usage" {
+  description = "The number of public IP addresses in use"
+  value       = "${data.clouddk_account.example.ip_address_usage}"
+}
+
+output "data_clouddk_account_example_server_limit" {
+  description = "The maximum number of servers"
+  value       = "${data.clouddk_account.example.server_limit}"
+}
+
+output "data_clouddk_account_example_server_usage" {
+  description = "The number of servers in use"
+  value       = "${data.clouddk_account.example.server_usage}"
+}
@@ -0,0 +1,14 @@
+data "clouddk_package" "example" {
+  min_cpus   = 2
+  min_memory = 4096
+}
+
+output "data_clouddk_package_example_id" {
+  description = "The package identifier"
+  value       = "${data.clouddk_package.example.id}"
+}
+
+output "data_clouddk_package_example_monthly_price" {
+  description = "The monthly price"
+  value       = "${data.clouddk_package.example.monthly_price}"
+}
@@ -0,0 +1,8 @@
+data "clouddk_server_backups" "example" {
+  server_id = "${clouddk_server.example.id}"
+}
+
+output "data_clouddk_server_backups_example_ids" {
+  description = "The backup identifiers"
+  value       = "${data.clouddk_server_backups.example.ids}"
+}
@@ -0,0 +1,13 @@
+data "clouddk_ssh_keys" "example" {
+  depends_on = ["clouddk_ssh_key.example"]
+}
+
+output "data_clouddk_ssh_keys_example_ids" {
+  description = "The SSH key identifiers"
+  value       = "${data.clouddk_ssh_keys.example.ids}"
+}
+
+output "data_clouddk_ssh_keys_example_names" {
+  description = "The SSH key names"
+  value       = "${data.clouddk_ssh_keys.example.names}"
+}
@@ -0,0 +1,15 @@
+data "clouddk_template" "example" {
+  most_recent        = true
+  os_family          = "ubuntu"
+  version_constraint = ">= 18.04"
+}
+
+output "data_clouddk_template_example_id" {
+  description = "The template identifier"
+  value       = "${data.clouddk_template.example.id}"
+}
+
+output "data_clouddk_template_example_version" {
+  description = "The operating system version"
+  value       = "${data.clouddk_template.example.version}"
+}
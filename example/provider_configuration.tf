@@ -2,8 +2,8 @@ provider "clouddk" {
   key = "${var.key}"
 }
 
-provider "random" {
-  version = "~> 2.1"
+provider "tls" {
+  version = "~> 2.2"
 }
 
 variable "key" {
@@ -0,0 +1,10 @@
+resource "clouddk_private_network" "example" {
+  cidr        = "10.0.0.0/24"
+  label       = "Terraform Example"
+  location_id = "${clouddk_server.example.location_id}"
+}
+
+output "resource_clouddk_private_network_example_id" {
+  description = "The private network identifier"
+  value       = "${clouddk_private_network.example.id}"
+}
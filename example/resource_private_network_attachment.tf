@@ -0,0 +1,15 @@
+resource "clouddk_private_network_attachment" "example" {
+  ip_address         = "10.0.0.10"
+  private_network_id = "${clouddk_private_network.example.id}"
+  server_id          = "${clouddk_server.example.id}"
+}
+
+output "resource_clouddk_private_network_attachment_example_ip_address" {
+  description = "The private IP address"
+  value       = "${clouddk_private_network_attachment.example.ip_address}"
+}
+
+output "resource_clouddk_private_network_attachment_example_network_interface_id" {
+  description = "The network interface identifier"
+  value       = "${clouddk_private_network_attachment.example.network_interface_id}"
+}
@@ -0,0 +1,8 @@
+resource "clouddk_reverse_dns" "example" {
+  server_id = "${clouddk_server.example.id}"
+}
+
+output "resource_clouddk_reverse_dns_example_reverse_dns" {
+  description = "The reverse DNS record"
+  value       = "${clouddk_reverse_dns.example.reverse_dns}"
+}
@@ -1,23 +1,25 @@
 resource "clouddk_server" "example" {
-  hostname      = "terraform-provider-clouddk-example"
-  label         = "Terraform Example"
-  root_password = "${random_string.clouddk_server_example_root_password.result}"
+  hostname = "terraform-provider-clouddk-example"
+  label    = "Terraform Example"
 
   location_id = "${element(data.clouddk_locations.example.ids, 0)}"
   package_id  = "${element(data.clouddk_packages.example.ids, index(data.clouddk_packages.example.names, "clouddk.s1"))}"
   template_id = "ubuntu-18.04-x64"
 
-  connection {
-    type = "ssh"
-
-    agent = false
+  disk {
+    label = "Inline Data"
+    size  = 10
+  }
 
-    host     = "${element(flatten(self.network_interface_addresses), 0)}"
-    port     = 22
-    user     = "root"
-    password = "${random_string.clouddk_server_example_root_password.result}"
+  firewall_rule {
+    command  = "ACCEPT"
+    protocol = "TCP"
+    address  = "0.0.0.0/0"
+    port     = "22"
+  }
 
-    timeout = "300s"
+  wait_for_ssh {
+    timeout = 600
   }
 
   provisioner "remote-exec" {
@@ -29,11 +31,6 @@ resource "clouddk_server" "example" {
   }
 }
 
-resource "random_string" "clouddk_server_example_root_password" {
-  length  = 32
-  special = false
-}
-
 output "resource_clouddk_server_example_booted" {
   description = "Whether the server has been booted"
   value       = "${clouddk_server.example.booted}"
@@ -44,6 +41,11 @@ output "resource_clouddk_server_example_cpus" {
   value       = "${clouddk_server.example.cpus}"
 }
 
+output "resource_clouddk_server_example_disk" {
+  description = "The disks managed by the disk blocks"
+  value       = "${clouddk_server.example.disk}"
+}
+
 output "resource_clouddk_server_example_disk_ids" {
   description = "The server's disk identifiers"
   value       = "${clouddk_server.example.disk_ids}"
@@ -174,6 +176,11 @@ output "resource_clouddk_server_example_package_name" {
   value       = "${clouddk_server.example.package_name}"
 }
 
+output "resource_clouddk_server_example_primary_ipv4_address" {
+  description = "The primary IPv4 address"
+  value       = "${clouddk_server.example.primary_ipv4_address}"
+}
+
 output "resource_clouddk_server_example_template_id" {
   description = "The template identifier"
   value       = "${clouddk_server.example.template_id}"
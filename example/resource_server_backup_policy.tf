@@ -0,0 +1,12 @@
+resource "clouddk_server_backup_policy" "example" {
+  schedule  = "daily"
+  hour      = 3
+  retention = 7
+
+  server_id = "${clouddk_server.example.id}"
+}
+
+output "resource_clouddk_server_backup_policy_example_enabled" {
+  description = "Whether automatic backups are enabled"
+  value       = "${clouddk_server_backup_policy.example.enabled}"
+}
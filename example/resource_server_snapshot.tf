@@ -0,0 +1,15 @@
+resource "clouddk_server_snapshot" "example" {
+  label = "Terraform Example"
+
+  server_id = "${clouddk_server.example.id}"
+}
+
+output "resource_clouddk_server_snapshot_example_created_at" {
+  description = "The creation timestamp"
+  value       = "${clouddk_server_snapshot.example.created_at}"
+}
+
+output "resource_clouddk_server_snapshot_example_id" {
+  description = "The snapshot identifier"
+  value       = "${clouddk_server_snapshot.example.id}"
+}
@@ -0,0 +1,19 @@
+resource "tls_private_key" "clouddk_ssh_key_example" {
+  algorithm = "RSA"
+  rsa_bits  = 4096
+}
+
+resource "clouddk_ssh_key" "example" {
+  name       = "Terraform Example"
+  public_key = "${tls_private_key.clouddk_ssh_key_example.public_key_openssh}"
+}
+
+output "resource_clouddk_ssh_key_example_fingerprint" {
+  description = "The SHA256 fingerprint of the public key"
+  value       = "${clouddk_ssh_key.example.fingerprint}"
+}
+
+output "resource_clouddk_ssh_key_example_id" {
+  description = "The SSH key identifier"
+  value       = "${clouddk_ssh_key.example.id}"
+}